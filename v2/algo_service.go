@@ -0,0 +1,562 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	algoMarketFutures = "futures"
+	algoMarketSpot    = "spot"
+)
+
+// CreateFuturesAlgoVpOrderService create a futures volume participation algo order
+type CreateFuturesAlgoVpOrderService struct {
+	c            *Client
+	symbol       string
+	side         SideType
+	positionSide *AlgoPositionSideType
+	quantity     string
+	urgency      AlgoUrgencyType
+	clientAlgoID *string
+	reduceOnly   *bool
+	limitPrice   *string
+}
+
+// Symbol set symbol
+func (s *CreateFuturesAlgoVpOrderService) Symbol(symbol string) *CreateFuturesAlgoVpOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateFuturesAlgoVpOrderService) Side(side SideType) *CreateFuturesAlgoVpOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateFuturesAlgoVpOrderService) PositionSide(positionSide AlgoPositionSideType) *CreateFuturesAlgoVpOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateFuturesAlgoVpOrderService) Quantity(quantity string) *CreateFuturesAlgoVpOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateFuturesAlgoVpOrderService) QuantityDecimal(d common.Decimal) *CreateFuturesAlgoVpOrderService {
+	return s.Quantity(d.String())
+}
+
+// Urgency set urgency
+func (s *CreateFuturesAlgoVpOrderService) Urgency(urgency AlgoUrgencyType) *CreateFuturesAlgoVpOrderService {
+	s.urgency = urgency
+	return s
+}
+
+// ClientAlgoID set clientAlgoId
+func (s *CreateFuturesAlgoVpOrderService) ClientAlgoID(clientAlgoID string) *CreateFuturesAlgoVpOrderService {
+	s.clientAlgoID = &clientAlgoID
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateFuturesAlgoVpOrderService) ReduceOnly(reduceOnly bool) *CreateFuturesAlgoVpOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// LimitPrice set limitPrice
+func (s *CreateFuturesAlgoVpOrderService) LimitPrice(limitPrice string) *CreateFuturesAlgoVpOrderService {
+	s.limitPrice = &limitPrice
+	return s
+}
+
+// LimitPriceDecimal set limitPrice from a decimal
+func (s *CreateFuturesAlgoVpOrderService) LimitPriceDecimal(d common.Decimal) *CreateFuturesAlgoVpOrderService {
+	return s.LimitPrice(d.String())
+}
+
+// Do send request
+func (s *CreateFuturesAlgoVpOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateAlgoOrderResponse, err error) {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+		"urgency":  s.urgency,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.clientAlgoID != nil {
+		m["clientAlgoId"] = *s.clientAlgoID
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.limitPrice != nil {
+		m["limitPrice"] = *s.limitPrice
+	}
+	return createAlgoOrder(ctx, s.c, "/sapi/v1/algo/futures/newOrderVp", m, opts...)
+}
+
+// CreateFuturesAlgoTwapOrderService create a futures time-weighted average price algo order
+type CreateFuturesAlgoTwapOrderService struct {
+	c            *Client
+	symbol       string
+	side         SideType
+	positionSide *AlgoPositionSideType
+	quantity     string
+	duration     int64
+	clientAlgoID *string
+	reduceOnly   *bool
+	limitPrice   *string
+}
+
+// Symbol set symbol
+func (s *CreateFuturesAlgoTwapOrderService) Symbol(symbol string) *CreateFuturesAlgoTwapOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateFuturesAlgoTwapOrderService) Side(side SideType) *CreateFuturesAlgoTwapOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateFuturesAlgoTwapOrderService) PositionSide(positionSide AlgoPositionSideType) *CreateFuturesAlgoTwapOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateFuturesAlgoTwapOrderService) Quantity(quantity string) *CreateFuturesAlgoTwapOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateFuturesAlgoTwapOrderService) QuantityDecimal(d common.Decimal) *CreateFuturesAlgoTwapOrderService {
+	return s.Quantity(d.String())
+}
+
+// Duration set duration in seconds, from 300 to 86400
+func (s *CreateFuturesAlgoTwapOrderService) Duration(duration int64) *CreateFuturesAlgoTwapOrderService {
+	s.duration = duration
+	return s
+}
+
+// ClientAlgoID set clientAlgoId
+func (s *CreateFuturesAlgoTwapOrderService) ClientAlgoID(clientAlgoID string) *CreateFuturesAlgoTwapOrderService {
+	s.clientAlgoID = &clientAlgoID
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateFuturesAlgoTwapOrderService) ReduceOnly(reduceOnly bool) *CreateFuturesAlgoTwapOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// LimitPrice set limitPrice
+func (s *CreateFuturesAlgoTwapOrderService) LimitPrice(limitPrice string) *CreateFuturesAlgoTwapOrderService {
+	s.limitPrice = &limitPrice
+	return s
+}
+
+// LimitPriceDecimal set limitPrice from a decimal
+func (s *CreateFuturesAlgoTwapOrderService) LimitPriceDecimal(d common.Decimal) *CreateFuturesAlgoTwapOrderService {
+	return s.LimitPrice(d.String())
+}
+
+// Do send request
+func (s *CreateFuturesAlgoTwapOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateAlgoOrderResponse, err error) {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+		"duration": s.duration,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.clientAlgoID != nil {
+		m["clientAlgoId"] = *s.clientAlgoID
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.limitPrice != nil {
+		m["limitPrice"] = *s.limitPrice
+	}
+	return createAlgoOrder(ctx, s.c, "/sapi/v1/algo/futures/newOrderTwap", m, opts...)
+}
+
+// CreateSpotAlgoTwapOrderService create a spot time-weighted average price algo order
+type CreateSpotAlgoTwapOrderService struct {
+	c            *Client
+	symbol       string
+	side         SideType
+	quantity     string
+	duration     int64
+	clientAlgoID *string
+	limitPrice   *string
+	stpMode      *STPModeType
+}
+
+// Symbol set symbol
+func (s *CreateSpotAlgoTwapOrderService) Symbol(symbol string) *CreateSpotAlgoTwapOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateSpotAlgoTwapOrderService) Side(side SideType) *CreateSpotAlgoTwapOrderService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateSpotAlgoTwapOrderService) Quantity(quantity string) *CreateSpotAlgoTwapOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateSpotAlgoTwapOrderService) QuantityDecimal(d common.Decimal) *CreateSpotAlgoTwapOrderService {
+	return s.Quantity(d.String())
+}
+
+// Duration set duration in seconds, from 300 to 86400
+func (s *CreateSpotAlgoTwapOrderService) Duration(duration int64) *CreateSpotAlgoTwapOrderService {
+	s.duration = duration
+	return s
+}
+
+// ClientAlgoID set clientAlgoId
+func (s *CreateSpotAlgoTwapOrderService) ClientAlgoID(clientAlgoID string) *CreateSpotAlgoTwapOrderService {
+	s.clientAlgoID = &clientAlgoID
+	return s
+}
+
+// LimitPrice set limitPrice
+func (s *CreateSpotAlgoTwapOrderService) LimitPrice(limitPrice string) *CreateSpotAlgoTwapOrderService {
+	s.limitPrice = &limitPrice
+	return s
+}
+
+// LimitPriceDecimal set limitPrice from a decimal
+func (s *CreateSpotAlgoTwapOrderService) LimitPriceDecimal(d common.Decimal) *CreateSpotAlgoTwapOrderService {
+	return s.LimitPrice(d.String())
+}
+
+// SelfTradePreventionMode set stpMode
+func (s *CreateSpotAlgoTwapOrderService) SelfTradePreventionMode(stpMode STPModeType) *CreateSpotAlgoTwapOrderService {
+	s.stpMode = &stpMode
+	return s
+}
+
+// Do send request
+func (s *CreateSpotAlgoTwapOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateAlgoOrderResponse, err error) {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+		"duration": s.duration,
+	}
+	if s.clientAlgoID != nil {
+		m["clientAlgoId"] = *s.clientAlgoID
+	}
+	if s.limitPrice != nil {
+		m["limitPrice"] = *s.limitPrice
+	}
+	if s.stpMode != nil {
+		m["stpMode"] = *s.stpMode
+	}
+	return createAlgoOrder(ctx, s.c, "/sapi/v1/algo/spot/newOrderTwap", m, opts...)
+}
+
+func createAlgoOrder(ctx context.Context, c *Client, endpoint string, m params, opts ...RequestOption) (res *CreateAlgoOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(m)
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateAlgoOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateAlgoOrderResponse define create algo order response
+type CreateAlgoOrderResponse struct {
+	ClientAlgoID string `json:"clientAlgoId"`
+	Success      bool   `json:"success"`
+	Code         int64  `json:"code"`
+	Msg          string `json:"msg"`
+}
+
+// CancelAlgoOrderService cancel an active algo order
+type CancelAlgoOrderService struct {
+	c      *Client
+	market string
+	algoID int64
+}
+
+// AlgoID set algoId
+func (s *CancelAlgoOrderService) AlgoID(algoID int64) *CancelAlgoOrderService {
+	s.algoID = algoID
+	return s
+}
+
+// Do send request
+func (s *CancelAlgoOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelAlgoOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: fmt.Sprintf("/sapi/v1/algo/%s/order", s.market),
+		secType:  secTypeSigned,
+	}
+	r.setParam("algoId", s.algoID)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CancelAlgoOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelAlgoOrderResponse define cancel algo order response
+type CancelAlgoOrderResponse struct {
+	AlgoID  int64  `json:"algoId"`
+	Success bool   `json:"success"`
+	Code    int64  `json:"code"`
+	Msg     string `json:"msg"`
+}
+
+// ListAlgoOpenOrdersService list open algo orders
+type ListAlgoOpenOrdersService struct {
+	c      *Client
+	market string
+}
+
+// Do send request
+func (s *ListAlgoOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *AlgoOrderList, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: fmt.Sprintf("/sapi/v1/algo/%s/openOrders", s.market),
+		secType:  secTypeSigned,
+	}
+	return listAlgoOrders(ctx, s.c, r, opts...)
+}
+
+// ListAlgoHistoricalOrdersService list historical algo orders
+type ListAlgoHistoricalOrdersService struct {
+	c         *Client
+	market    string
+	symbol    *string
+	side      *SideType
+	startTime *int64
+	endTime   *int64
+	page      *int
+	pageSize  *int
+}
+
+// Symbol set symbol
+func (s *ListAlgoHistoricalOrdersService) Symbol(symbol string) *ListAlgoHistoricalOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Side set side
+func (s *ListAlgoHistoricalOrdersService) Side(side SideType) *ListAlgoHistoricalOrdersService {
+	s.side = &side
+	return s
+}
+
+// StartTime set startTime
+func (s *ListAlgoHistoricalOrdersService) StartTime(startTime int64) *ListAlgoHistoricalOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListAlgoHistoricalOrdersService) EndTime(endTime int64) *ListAlgoHistoricalOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Page set page
+func (s *ListAlgoHistoricalOrdersService) Page(page int) *ListAlgoHistoricalOrdersService {
+	s.page = &page
+	return s
+}
+
+// PageSize set pageSize
+func (s *ListAlgoHistoricalOrdersService) PageSize(pageSize int) *ListAlgoHistoricalOrdersService {
+	s.pageSize = &pageSize
+	return s
+}
+
+// Do send request
+func (s *ListAlgoHistoricalOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *AlgoOrderList, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: fmt.Sprintf("/sapi/v1/algo/%s/historicalOrders", s.market),
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	if s.side != nil {
+		r.setParam("side", *s.side)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.page != nil {
+		r.setParam("page", *s.page)
+	}
+	if s.pageSize != nil {
+		r.setParam("pageSize", *s.pageSize)
+	}
+	return listAlgoOrders(ctx, s.c, r, opts...)
+}
+
+func listAlgoOrders(ctx context.Context, c *Client, r *request, opts ...RequestOption) (res *AlgoOrderList, err error) {
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AlgoOrderList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AlgoOrderList define a page of algo orders
+type AlgoOrderList struct {
+	Total  int64        `json:"total"`
+	Orders []*AlgoOrder `json:"orders"`
+}
+
+// AlgoOrder define algo order info
+type AlgoOrder struct {
+	AlgoID           int64                `json:"algoId"`
+	Symbol           string               `json:"symbol"`
+	Side             SideType             `json:"side"`
+	PositionSide     AlgoPositionSideType `json:"positionSide"`
+	TotalQuantity    string               `json:"totalQty"`
+	ExecutedQuantity string               `json:"executedQty"`
+	ExecutedAmount   string               `json:"executedAmt"`
+	AvgPrice         string               `json:"avgPrice"`
+	ClientAlgoID     string               `json:"clientAlgoId"`
+	BookTime         int64                `json:"bookTime"`
+	EndTime          int64                `json:"endTime"`
+	AlgoStatus       AlgoStatusType       `json:"algoStatus"`
+	AlgoType         string               `json:"algoType"`
+	Urgency          AlgoUrgencyType      `json:"urgency"`
+}
+
+// ListAlgoSubOrdersService list the sub orders of an algo order
+type ListAlgoSubOrdersService struct {
+	c        *Client
+	market   string
+	algoID   int64
+	page     *int
+	pageSize *int
+}
+
+// AlgoID set algoId
+func (s *ListAlgoSubOrdersService) AlgoID(algoID int64) *ListAlgoSubOrdersService {
+	s.algoID = algoID
+	return s
+}
+
+// Page set page
+func (s *ListAlgoSubOrdersService) Page(page int) *ListAlgoSubOrdersService {
+	s.page = &page
+	return s
+}
+
+// PageSize set pageSize
+func (s *ListAlgoSubOrdersService) PageSize(pageSize int) *ListAlgoSubOrdersService {
+	s.pageSize = &pageSize
+	return s
+}
+
+// Do send request
+func (s *ListAlgoSubOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *AlgoSubOrderList, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: fmt.Sprintf("/sapi/v1/algo/%s/subOrders", s.market),
+		secType:  secTypeSigned,
+	}
+	r.setParam("algoId", s.algoID)
+	if s.page != nil {
+		r.setParam("page", *s.page)
+	}
+	if s.pageSize != nil {
+		r.setParam("pageSize", *s.pageSize)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AlgoSubOrderList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AlgoSubOrderList define a page of algo sub orders
+type AlgoSubOrderList struct {
+	Total            int64           `json:"total"`
+	ExecutedQuantity string          `json:"executedQty"`
+	ExecutedAmount   string          `json:"executedAmt"`
+	SubOrders        []*AlgoSubOrder `json:"subOrders"`
+}
+
+// AlgoSubOrder define algo sub order info
+type AlgoSubOrder struct {
+	AlgoID           int64           `json:"algoId"`
+	OrderID          int64           `json:"orderId"`
+	OrderStatus      OrderStatusType `json:"orderStatus"`
+	ExecutedQuantity string          `json:"executedQty"`
+	ExecutedAmount   string          `json:"executedAmt"`
+	FeeAmount        string          `json:"feeAmt"`
+	FeeAsset         string          `json:"feeAsset"`
+	BookTime         int64           `json:"bookTime"`
+	AvgPrice         string          `json:"avgPrice"`
+	Side             SideType        `json:"side"`
+	Symbol           string          `json:"symbol"`
+	SubID            int64           `json:"subId"`
+	TimeInForce      TimeInForceType `json:"timeInForce"`
+	OrigQuantity     string          `json:"origQty"`
+}
@@ -0,0 +1,300 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type algoServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestAlgoService(t *testing.T) {
+	suite.Run(t, new(algoServiceTestSuite))
+}
+
+func (s *algoServiceTestSuite) assertPath(path string) {
+	req := s.client.Calls[0].Arguments.Get(0).(*http.Request)
+	s.r().Equal(path, req.URL.Path)
+}
+
+func (s *algoServiceTestSuite) TestCreateFuturesAlgoVpOrder() {
+	data := []byte(`{
+		"clientAlgoId": "00358ce6a268403398bd34eaa36dffe7",
+		"success": true,
+		"code": 0,
+		"msg": "OK"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":       "BTCUSDT",
+			"side":         SideTypeSell,
+			"positionSide": AlgoPositionSideTypeBoth,
+			"quantity":     "3",
+			"urgency":      AlgoUrgencyTypeHigh,
+			"clientAlgoId": "00358ce6a268403398bd34eaa36dffe7",
+			"reduceOnly":   true,
+			"limitPrice":   "16000",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateFuturesAlgoVpOrderService().Symbol("BTCUSDT").
+		Side(SideTypeSell).PositionSide(AlgoPositionSideTypeBoth).Quantity("3").
+		Urgency(AlgoUrgencyTypeHigh).ClientAlgoID("00358ce6a268403398bd34eaa36dffe7").
+		ReduceOnly(true).LimitPrice("16000").Do(newContext())
+	s.r().NoError(err)
+	s.assertPath("/sapi/v1/algo/futures/newOrderVp")
+	s.r().Equal(&CreateAlgoOrderResponse{
+		ClientAlgoID: "00358ce6a268403398bd34eaa36dffe7",
+		Success:      true,
+		Code:         0,
+		Msg:          "OK",
+	}, res)
+}
+
+func (s *algoServiceTestSuite) TestCreateFuturesAlgoTwapOrder() {
+	data := []byte(`{
+		"clientAlgoId": "65ce1630101a480b85915d7e11fd5078",
+		"success": true,
+		"code": 0,
+		"msg": "OK"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   "BTCUSDT",
+			"side":     SideTypeBuy,
+			"quantity": "1.5",
+			"duration": 86400,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateFuturesAlgoTwapOrderService().Symbol("BTCUSDT").
+		Side(SideTypeBuy).Quantity("1.5").Duration(86400).Do(newContext())
+	s.r().NoError(err)
+	s.assertPath("/sapi/v1/algo/futures/newOrderTwap")
+	s.r().Equal("65ce1630101a480b85915d7e11fd5078", res.ClientAlgoID)
+	s.r().True(res.Success)
+}
+
+func (s *algoServiceTestSuite) TestCreateSpotAlgoTwapOrder() {
+	data := []byte(`{
+		"clientAlgoId": "65ce1630101a480b85915d7e11fd5078",
+		"success": true,
+		"code": 0,
+		"msg": "OK"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":     "BTCUSDT",
+			"side":       SideTypeBuy,
+			"quantity":   "0.012",
+			"duration":   3600,
+			"limitPrice": "30000",
+			"stpMode":    STPModeExpireTaker,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewCreateSpotAlgoTwapOrderService().Symbol("BTCUSDT").
+		Side(SideTypeBuy).Quantity("0.012").Duration(3600).LimitPrice("30000").
+		SelfTradePreventionMode(STPModeExpireTaker).Do(newContext())
+	s.r().NoError(err)
+	s.assertPath("/sapi/v1/algo/spot/newOrderTwap")
+}
+
+func (s *algoServiceTestSuite) TestCancelAlgoOrder() {
+	data := []byte(`{
+		"algoId": 14511,
+		"success": true,
+		"code": 0,
+		"msg": "OK"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("algoId", 14511)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCancelSpotAlgoOrderService().AlgoID(14511).Do(newContext())
+	s.r().NoError(err)
+	s.assertPath("/sapi/v1/algo/spot/order")
+	s.r().Equal(&CancelAlgoOrderResponse{
+		AlgoID:  14511,
+		Success: true,
+		Code:    0,
+		Msg:     "OK",
+	}, res)
+}
+
+func (s *algoServiceTestSuite) TestListAlgoOpenOrders() {
+	data := []byte(`{
+		"total": 1,
+		"orders": [
+			{
+				"algoId": 14517,
+				"symbol": "ETHUSDT",
+				"side": "SELL",
+				"positionSide": "SHORT",
+				"totalQty": "5.000",
+				"executedQty": "0.000",
+				"executedAmt": "0.00000000",
+				"avgPrice": "0.00",
+				"clientAlgoId": "d7096549481642f8a0bb69e9e2e31f2e",
+				"bookTime": 1649756817004,
+				"endTime": 0,
+				"algoStatus": "WORKING",
+				"algoType": "VP",
+				"urgency": "LOW"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.NewListFuturesAlgoOpenOrdersService().Do(newContext())
+	s.r().NoError(err)
+	s.assertPath("/sapi/v1/algo/futures/openOrders")
+	s.r().Equal(&AlgoOrderList{
+		Total: 1,
+		Orders: []*AlgoOrder{
+			{
+				AlgoID:           14517,
+				Symbol:           "ETHUSDT",
+				Side:             SideTypeSell,
+				PositionSide:     AlgoPositionSideTypeShort,
+				TotalQuantity:    "5.000",
+				ExecutedQuantity: "0.000",
+				ExecutedAmount:   "0.00000000",
+				AvgPrice:         "0.00",
+				ClientAlgoID:     "d7096549481642f8a0bb69e9e2e31f2e",
+				BookTime:         1649756817004,
+				EndTime:          0,
+				AlgoStatus:       AlgoStatusTypeWorking,
+				AlgoType:         "VP",
+				Urgency:          AlgoUrgencyTypeLow,
+			},
+		},
+	}, res)
+}
+
+func (s *algoServiceTestSuite) TestListAlgoHistoricalOrders() {
+	data := []byte(`{
+		"total": 1,
+		"orders": [
+			{
+				"algoId": 14518,
+				"symbol": "BNBUSDT",
+				"side": "BUY",
+				"totalQty": "100.00",
+				"executedQty": "0.00",
+				"executedAmt": "0.00000000",
+				"avgPrice": "0.000",
+				"clientAlgoId": "acacab56b3c44bef9f6a8f8ebd2a8408",
+				"bookTime": 1649757019503,
+				"endTime": 1649757088101,
+				"algoStatus": "CANCELLED",
+				"algoType": "TWAP"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":    "BNBUSDT",
+			"side":      SideTypeBuy,
+			"startTime": 1649756817004,
+			"endTime":   1649757088101,
+			"page":      1,
+			"pageSize":  100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListSpotAlgoHistoricalOrdersService().Symbol("BNBUSDT").
+		Side(SideTypeBuy).StartTime(1649756817004).EndTime(1649757088101).
+		Page(1).PageSize(100).Do(newContext())
+	s.r().NoError(err)
+	s.assertPath("/sapi/v1/algo/spot/historicalOrders")
+	s.r().Len(res.Orders, 1)
+	s.r().Equal(AlgoStatusTypeCancelled, res.Orders[0].AlgoStatus)
+	s.r().Equal("TWAP", res.Orders[0].AlgoType)
+}
+
+func (s *algoServiceTestSuite) TestListAlgoSubOrders() {
+	data := []byte(`{
+		"total": 1,
+		"executedQty": "1.000",
+		"executedAmt": "3229.44000000",
+		"subOrders": [
+			{
+				"algoId": 13723,
+				"orderId": 8389765519993908929,
+				"orderStatus": "FILLED",
+				"executedQty": "1.000",
+				"executedAmt": "3229.44000000",
+				"feeAmt": "-1.61471999",
+				"feeAsset": "USDT",
+				"bookTime": 1649319001964,
+				"avgPrice": "3229.44",
+				"side": "SELL",
+				"symbol": "ETHUSDT",
+				"subId": 1,
+				"timeInForce": "IMMEDIATE_OR_CANCEL",
+				"origQty": "1.000"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"algoId":   13723,
+			"page":     1,
+			"pageSize": 10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListFuturesAlgoSubOrdersService().AlgoID(13723).
+		Page(1).PageSize(10).Do(newContext())
+	s.r().NoError(err)
+	s.assertPath("/sapi/v1/algo/futures/subOrders")
+	s.r().Equal(&AlgoSubOrderList{
+		Total:            1,
+		ExecutedQuantity: "1.000",
+		ExecutedAmount:   "3229.44000000",
+		SubOrders: []*AlgoSubOrder{
+			{
+				AlgoID:           13723,
+				OrderID:          8389765519993908929,
+				OrderStatus:      OrderStatusTypeFilled,
+				ExecutedQuantity: "1.000",
+				ExecutedAmount:   "3229.44000000",
+				FeeAmount:        "-1.61471999",
+				FeeAsset:         "USDT",
+				BookTime:         1649319001964,
+				AvgPrice:         "3229.44",
+				Side:             SideTypeSell,
+				Symbol:           "ETHUSDT",
+				SubID:            1,
+				TimeInForce:      "IMMEDIATE_OR_CANCEL",
+				OrigQuantity:     "1.000",
+			},
+		},
+	}, res)
+}
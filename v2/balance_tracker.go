@@ -0,0 +1,385 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// TrackedBalance define the balance of an asset kept by a BalanceTracker
+type TrackedBalance struct {
+	Asset      string
+	Free       common.Decimal
+	Locked     common.Decimal
+	UpdateTime int64
+}
+
+// Total return the free and locked balance
+func (b TrackedBalance) Total() common.Decimal {
+	return b.Free.Add(b.Locked)
+}
+
+func (b TrackedBalance) same(other TrackedBalance) bool {
+	return b.Free.Equal(other.Free) && b.Locked.Equal(other.Locked)
+}
+
+// BalanceChangeHandler handle a balance change
+type BalanceChangeHandler func(b TrackedBalance)
+
+// BalanceDriftHandler handle a balance found different from the tracked one
+// by a new snapshot
+type BalanceDriftHandler func(tracked, snapshot TrackedBalance)
+
+type balanceAccountType int
+
+const (
+	balanceAccountSpot balanceAccountType = iota
+	balanceAccountMargin
+	balanceAccountIsolatedMargin
+)
+
+// BalanceTracker keep the balances of the spot, cross margin or isolated
+// margin account from a REST snapshot and the outboundAccountPosition and
+// balanceUpdate events of the user data stream of the same account.
+//
+// Call Sync again, or StartResync to do it on a schedule, to detect drift:
+// the snapshot replaces the tracked balances and the assets that differ are
+// reported to the drift handler.
+type BalanceTracker struct {
+	c           *Client
+	accountType balanceAccountType
+	symbol      string
+	onChange    BalanceChangeHandler
+	onDrift     BalanceDriftHandler
+	errHandler  ErrHandler
+
+	mu       sync.Mutex
+	balances map[string]*TrackedBalance
+	synced   bool
+	syncing  bool
+	events   []*WsUserDataEvent
+}
+
+// Margin track the cross margin account
+func (t *BalanceTracker) Margin() *BalanceTracker {
+	t.accountType = balanceAccountMargin
+	return t
+}
+
+// IsolatedMargin track the isolated margin account of symbol
+func (t *BalanceTracker) IsolatedMargin(symbol string) *BalanceTracker {
+	t.accountType = balanceAccountIsolatedMargin
+	t.symbol = symbol
+	return t
+}
+
+// OnChange set the handler called with every balance that changed
+func (t *BalanceTracker) OnChange(handler BalanceChangeHandler) *BalanceTracker {
+	t.onChange = handler
+	return t
+}
+
+// OnDrift set the handler called with the balances a snapshot corrected
+func (t *BalanceTracker) OnDrift(handler BalanceDriftHandler) *BalanceTracker {
+	t.onDrift = handler
+	return t
+}
+
+// OnError set the handler of errors met while handling stream events
+func (t *BalanceTracker) OnError(errHandler ErrHandler) *BalanceTracker {
+	t.errHandler = errHandler
+	return t
+}
+
+// Balance return the balance of asset
+func (t *BalanceTracker) Balance(asset string) (TrackedBalance, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b, ok := t.balances[asset]
+	if !ok {
+		return TrackedBalance{}, false
+	}
+	return *b, true
+}
+
+// Balances return the non zero balances, by asset
+func (t *BalanceTracker) Balances() []TrackedBalance {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	res := make([]TrackedBalance, 0, len(t.balances))
+	for _, b := range t.balances {
+		if !b.Total().IsZero() {
+			res = append(res, *b)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Asset < res[j].Asset })
+	return res
+}
+
+func (t *BalanceTracker) handleErr(err error) {
+	if err != nil && t.errHandler != nil {
+		t.errHandler(err)
+	}
+}
+
+func (t *BalanceTracker) notify(changed []TrackedBalance) {
+	if t.onChange == nil {
+		return
+	}
+	for _, b := range changed {
+		t.onChange(b)
+	}
+}
+
+// snapshot fetch the balances of the account and the time they are valid
+// at. exact is false when the account has no server update time and the
+// local request time is used instead, the snapshot may then already include
+// events received after it.
+func (t *BalanceTracker) snapshot(ctx context.Context, opts ...RequestOption) (balances map[string]*TrackedBalance, snapshotTime int64, exact bool, err error) {
+	requestTime := currentTimestamp() - t.c.TimeOffset
+	var assets [][3]string
+	switch t.accountType {
+	case balanceAccountSpot:
+		res, err := t.c.NewGetAccountService().Do(ctx, opts...)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		if res.UpdateTime > 0 {
+			requestTime = int64(res.UpdateTime)
+			exact = true
+		}
+		for _, b := range res.Balances {
+			assets = append(assets, [3]string{b.Asset, b.Free, b.Locked})
+		}
+	case balanceAccountMargin:
+		res, err := t.c.NewGetMarginAccountService().Do(ctx, opts...)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		for _, a := range res.UserAssets {
+			assets = append(assets, [3]string{a.Asset, a.Free, a.Locked})
+		}
+	case balanceAccountIsolatedMargin:
+		res, err := t.c.NewGetIsolatedMarginAccountService().Symbols(t.symbol).Do(ctx, opts...)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		for _, a := range res.Assets {
+			if a.Symbol != t.symbol {
+				continue
+			}
+			assets = append(assets, [3]string{a.BaseAsset.Asset, a.BaseAsset.Free, a.BaseAsset.Locked},
+				[3]string{a.QuoteAsset.Asset, a.QuoteAsset.Free, a.QuoteAsset.Locked})
+		}
+	}
+	balances = make(map[string]*TrackedBalance, len(assets))
+	for _, a := range assets {
+		values, err := parseTrackerDecimals(a[1], a[2])
+		if err != nil {
+			return nil, 0, false, err
+		}
+		balances[a[0]] = &TrackedBalance{Asset: a[0], Free: values[0], Locked: values[1], UpdateTime: requestTime}
+	}
+	return balances, requestTime, exact, nil
+}
+
+// Sync replace the balances with a REST snapshot of the account. The events
+// newer than the snapshot are applied on top of it, and the balances that
+// still differ from the tracked ones are reported to the drift handler.
+//
+// The margin accounts have no update time, their snapshot is dated at the
+// request and only the absolute outboundAccountPosition events are applied
+// on top of it: a balanceUpdate received meanwhile may be part of the
+// snapshot already, it shows up again in the next outboundAccountPosition
+// or Sync.
+func (t *BalanceTracker) Sync(ctx context.Context, opts ...RequestOption) error {
+	t.mu.Lock()
+	if t.syncing {
+		t.mu.Unlock()
+		return errors.New("balance tracker: already syncing")
+	}
+	// keep applying the events, and record them to replay on the snapshot
+	t.syncing = true
+	t.events = nil
+	t.mu.Unlock()
+
+	balances, snapshotTime, exact, err := t.snapshot(ctx, opts...)
+
+	t.mu.Lock()
+	events := t.events
+	t.syncing = false
+	t.events = nil
+	if err != nil {
+		t.mu.Unlock()
+		return err
+	}
+	for _, event := range events {
+		if !exact && event.Event == UserDataEventTypeBalanceUpdate {
+			continue
+		}
+		if balanceEventTime(event) > snapshotTime {
+			// the parse errors were reported when the event was received
+			_, _ = applyBalanceEvent(balances, event)
+		}
+	}
+	var changed []TrackedBalance
+	type drift struct{ tracked, snapshot TrackedBalance }
+	var drifts []drift
+	for asset, b := range balances {
+		old, ok := t.balances[asset]
+		if (ok && old.same(*b)) || (!ok && b.Total().IsZero()) {
+			continue
+		}
+		changed = append(changed, *b)
+		if t.synced {
+			tracked := TrackedBalance{Asset: asset}
+			if ok {
+				tracked = *old
+			}
+			drifts = append(drifts, drift{tracked, *b})
+		}
+	}
+	for asset, old := range t.balances {
+		if _, ok := balances[asset]; !ok && t.synced && !old.Total().IsZero() {
+			b := TrackedBalance{Asset: asset, UpdateTime: snapshotTime}
+			balances[asset] = &b
+			changed = append(changed, b)
+			drifts = append(drifts, drift{*old, b})
+		}
+	}
+	t.balances = balances
+	t.synced = true
+	t.mu.Unlock()
+
+	if t.onDrift != nil {
+		for _, d := range drifts {
+			t.onDrift(d.tracked, d.snapshot)
+		}
+	}
+	t.notify(changed)
+	return nil
+}
+
+func balanceEventTime(event *WsUserDataEvent) int64 {
+	if event.Event == UserDataEventTypeOutboundAccountPosition && event.AccountUpdateTime > 0 {
+		return event.AccountUpdateTime
+	}
+	return event.Time
+}
+
+// applyBalanceEvent apply an outboundAccountPosition or balanceUpdate event
+// to balances, it return the balances that changed
+func applyBalanceEvent(balances map[string]*TrackedBalance, event *WsUserDataEvent) ([]TrackedBalance, error) {
+	eventTime := balanceEventTime(event)
+	balance := func(asset string) *TrackedBalance {
+		b, ok := balances[asset]
+		if !ok {
+			b = &TrackedBalance{Asset: asset}
+			balances[asset] = b
+		}
+		return b
+	}
+	var changed []TrackedBalance
+	switch event.Event {
+	case UserDataEventTypeOutboundAccountPosition:
+		var err error
+		for _, u := range event.AccountUpdate.WsAccountUpdates {
+			values, perr := parseTrackerDecimals(u.Free, u.Locked)
+			if perr != nil {
+				if err == nil {
+					err = perr
+				}
+				continue
+			}
+			b := balance(u.Asset)
+			// the balances are absolute, an older update is outdated
+			if eventTime < b.UpdateTime || (b.Free.Equal(values[0]) && b.Locked.Equal(values[1])) {
+				continue
+			}
+			b.Free, b.Locked, b.UpdateTime = values[0], values[1], eventTime
+			changed = append(changed, *b)
+		}
+		return changed, err
+	case UserDataEventTypeBalanceUpdate:
+		delta, err := common.ParseDecimal(event.BalanceUpdate.Change)
+		if err != nil {
+			return nil, err
+		}
+		b := balance(event.BalanceUpdate.Asset)
+		b.Free = b.Free.Add(delta)
+		if eventTime > b.UpdateTime {
+			b.UpdateTime = eventTime
+		}
+		changed = append(changed, *b)
+	}
+	return changed, nil
+}
+
+// HandleUserData apply the outboundAccountPosition and balanceUpdate events
+func (t *BalanceTracker) HandleUserData(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeOutboundAccountPosition && event.Event != UserDataEventTypeBalanceUpdate {
+		return
+	}
+	t.mu.Lock()
+	if t.syncing {
+		t.events = append(t.events, event)
+	}
+	changed, err := applyBalanceEvent(t.balances, event)
+	t.mu.Unlock()
+	t.handleErr(err)
+	t.notify(changed)
+}
+
+// ServeUserData serve the user data stream of listenKey into the tracker
+// and sync it once connected. Call it again once doneC is closed to
+// reconnect.
+func (t *BalanceTracker) ServeUserData(ctx context.Context, listenKey string) (doneC, stopC chan struct{}, err error) {
+	doneC, stopC, err = WsUserDataServe(listenKey, t.HandleUserData, t.handleErr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = t.Sync(ctx); err != nil {
+		close(stopC)
+		return nil, nil, err
+	}
+	return doneC, stopC, nil
+}
+
+// StartResync sync the balances every interval until stopC is closed,
+// the errors are passed to the error handler. It return an error if
+// interval is not positive.
+func (t *BalanceTracker) StartResync(interval time.Duration) (doneC, stopC chan struct{}, err error) {
+	if interval <= 0 {
+		return nil, nil, errors.New("balance tracker: resync interval must be positive")
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopC:
+				return
+			case <-ticker.C:
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				select {
+				case <-stopC:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			err := t.Sync(ctx)
+			cancel()
+			t.handleErr(err)
+		}
+	}()
+	return doneC, stopC, nil
+}
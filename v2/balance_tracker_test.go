@@ -0,0 +1,206 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type balanceTrackerTestSuite struct {
+	baseTestSuite
+	tracker *BalanceTracker
+	changes []TrackedBalance
+	drifts  [][2]TrackedBalance
+	errs    []error
+}
+
+func TestBalanceTracker(t *testing.T) {
+	suite.Run(t, new(balanceTrackerTestSuite))
+}
+
+func (s *balanceTrackerTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.client.Client.do = s.client.do
+	s.changes = nil
+	s.drifts = nil
+	s.errs = nil
+	s.tracker = s.client.NewBalanceTracker().
+		OnChange(func(b TrackedBalance) { s.changes = append(s.changes, b) }).
+		OnDrift(func(tracked, snapshot TrackedBalance) {
+			s.drifts = append(s.drifts, [2]TrackedBalance{tracked, snapshot})
+		}).
+		OnError(func(err error) { s.errs = append(s.errs, err) })
+}
+
+func (s *balanceTrackerTestSuite) balance(asset string) TrackedBalance {
+	b, ok := s.tracker.Balance(asset)
+	s.r().True(ok)
+	return b
+}
+
+const balanceTrackerAccount = `{"updateTime": 1000, "balances": [
+	{"asset": "BTC", "free": "1", "locked": "0.5"},
+	{"asset": "ETH", "free": "0", "locked": "0"}
+]}`
+
+func outboundAccountPosition(updateTime int64, asset, free, locked string) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event:             UserDataEventTypeOutboundAccountPosition,
+		Time:              updateTime,
+		AccountUpdateTime: updateTime,
+		AccountUpdate: WsAccountUpdateList{WsAccountUpdates: []WsAccountUpdate{
+			{Asset: asset, Free: free, Locked: locked},
+		}},
+	}
+}
+
+func balanceUpdate(eventTime int64, asset, change string) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event:         UserDataEventTypeBalanceUpdate,
+		Time:          eventTime,
+		BalanceUpdate: WsBalanceUpdate{Asset: asset, Change: change},
+	}
+}
+
+func (s *balanceTrackerTestSuite) TestSync() {
+	s.mockDoOnce([]byte(balanceTrackerAccount), nil)
+	s.r().NoError(s.tracker.Sync(newContext()))
+	s.r().Equal("/api/v3/account", s.calledRequest(0).URL.Path)
+
+	b := s.balance("BTC")
+	s.assertDecimal("1", b.Free)
+	s.assertDecimal("0.5", b.Locked)
+	s.assertDecimal("1.5", b.Total())
+	s.r().Equal(int64(1000), b.UpdateTime)
+	s.r().Len(s.tracker.Balances(), 1)
+	// the zero balances are not reported
+	s.r().Len(s.changes, 1)
+	s.r().Empty(s.drifts)
+}
+
+func (s *balanceTrackerTestSuite) TestEvents() {
+	s.mockDoOnce([]byte(balanceTrackerAccount), nil)
+	s.r().NoError(s.tracker.Sync(newContext()))
+	s.changes = nil
+
+	s.tracker.HandleUserData(outboundAccountPosition(2000, "BTC", "0.8", "0.7"))
+	s.assertDecimal("0.8", s.balance("BTC").Free)
+	// an older update is outdated
+	s.tracker.HandleUserData(outboundAccountPosition(1500, "BTC", "5", "0"))
+	s.assertDecimal("0.8", s.balance("BTC").Free)
+
+	s.tracker.HandleUserData(balanceUpdate(2100, "ETH", "2.5"))
+	s.tracker.HandleUserData(balanceUpdate(2200, "ETH", "-0.5"))
+	eth := s.balance("ETH")
+	s.assertDecimal("2", eth.Free)
+	s.r().Equal(int64(2200), eth.UpdateTime)
+	s.r().Len(s.changes, 3)
+	s.r().Len(s.tracker.Balances(), 2)
+
+	// other events are ignored
+	s.tracker.HandleUserData(&WsUserDataEvent{Event: UserDataEventTypeExecutionReport})
+	s.tracker.HandleUserData(balanceUpdate(2300, "ETH", "x"))
+	s.r().Len(s.changes, 3)
+	s.r().Len(s.errs, 1)
+}
+
+func (s *balanceTrackerTestSuite) TestDrift() {
+	s.mockDoOnce([]byte(balanceTrackerAccount), nil)
+	s.r().NoError(s.tracker.Sync(newContext()))
+	s.tracker.HandleUserData(balanceUpdate(2000, "BTC", "1"))
+	s.changes = nil
+
+	s.assertReq(func(r *request) {
+		// received while the snapshot is in flight, after it was taken
+		s.tracker.HandleUserData(balanceUpdate(4000, "ETH", "3"))
+	})
+	// the deposit of 1 BTC was reverted, and 2 ETH were deposited before the snapshot
+	s.mockDoOnce([]byte(`{"updateTime": 3000, "balances": [
+		{"asset": "BTC", "free": "1", "locked": "0.5"},
+		{"asset": "ETH", "free": "2", "locked": "0"}
+	]}`), nil)
+	s.r().NoError(s.tracker.Sync(newContext()))
+
+	s.assertDecimal("1", s.balance("BTC").Free)
+	s.assertDecimal("5", s.balance("ETH").Free)
+	s.r().Len(s.drifts, 2)
+	for _, d := range s.drifts {
+		switch d[0].Asset {
+		case "BTC":
+			s.assertDecimal("2", d[0].Free)
+			s.assertDecimal("1", d[1].Free)
+		case "ETH":
+			s.assertDecimal("3", d[0].Free)
+			s.assertDecimal("5", d[1].Free)
+		}
+	}
+}
+
+func (s *balanceTrackerTestSuite) TestMargin() {
+	s.tracker.Margin()
+	s.mockDoOnce([]byte(`{"userAssets": [{"asset": "USDT", "free": "100", "locked": "20", "borrowed": "50"}]}`), nil)
+	s.r().NoError(s.tracker.Sync(newContext()))
+	s.r().Equal("/sapi/v1/margin/account", s.calledRequest(0).URL.Path)
+	s.assertDecimal("120", s.balance("USDT").Total())
+}
+
+func (s *balanceTrackerTestSuite) TestMarginEventsDuringSync() {
+	s.tracker.Margin()
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		// a deposit and an order received while the snapshot is in flight,
+		// both already part of it
+		now := currentTimestamp() + 1
+		s.tracker.HandleUserData(balanceUpdate(now, "USDT", "10"))
+		s.tracker.HandleUserData(outboundAccountPosition(now, "BTC", "0.5", "0"))
+		return newHTTPResponse([]byte(`{"userAssets": [
+			{"asset": "USDT", "free": "110", "locked": "0"},
+			{"asset": "BTC", "free": "0.5", "locked": "0"}
+		]}`), http.StatusOK), nil
+	}
+	s.r().NoError(s.tracker.Sync(newContext()))
+	s.assertDecimal("110", s.balance("USDT").Free)
+	s.assertDecimal("0.5", s.balance("BTC").Free)
+	s.r().Empty(s.errs)
+}
+
+func (s *balanceTrackerTestSuite) TestIsolatedMargin() {
+	s.tracker.IsolatedMargin("BTCUSDT")
+	s.mockDoOnce([]byte(`{"assets": [{
+		"symbol": "BTCUSDT",
+		"baseAsset": {"asset": "BTC", "free": "0.1", "locked": "0"},
+		"quoteAsset": {"asset": "USDT", "free": "500", "locked": "100"}
+	}]}`), nil)
+	s.r().NoError(s.tracker.Sync(newContext()))
+	req := s.calledRequest(0)
+	s.r().Equal("/sapi/v1/margin/isolated/account", req.URL.Path)
+	s.r().Equal("BTCUSDT", req.URL.Query().Get("symbols"))
+	s.assertDecimal("0.1", s.balance("BTC").Free)
+	s.assertDecimal("100", s.balance("USDT").Locked)
+}
+
+func (s *balanceTrackerTestSuite) TestStartResync() {
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		return newHTTPResponse([]byte(balanceTrackerAccount), http.StatusOK), nil
+	}
+	driftC := make(chan TrackedBalance, 10)
+	s.tracker.OnDrift(func(tracked, snapshot TrackedBalance) { driftC <- snapshot })
+	s.r().NoError(s.tracker.Sync(newContext()))
+	s.tracker.HandleUserData(balanceUpdate(2000, "BTC", "1"))
+
+	doneC, stopC, err := s.tracker.StartResync(10 * time.Millisecond)
+	s.r().NoError(err)
+	select {
+	case b := <-driftC:
+		s.r().Equal("BTC", b.Asset)
+		s.assertDecimal("1", b.Free)
+	case <-time.After(time.Second):
+		s.Fail("no drift detected")
+	}
+	close(stopC)
+	<-doneC
+
+	_, _, err = s.tracker.StartResync(0)
+	s.r().Error(err)
+}
@@ -0,0 +1,575 @@
+// Package bracket protect spot and margin holdings with take-profit,
+// stop-loss and trailing stop brackets watched client-side.
+//
+// A Manager follows prices from WsBookTickerServe or WsTradeServe and
+// execution reports from the user data stream. When a trigger fires it
+// cancels the resting take-profit order, if any, and exits with a MARKET
+// order. Every change is written to a Store so that a restarted manager
+// picks up where it left off.
+package bracket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// State define the state of a bracket
+type State string
+
+// TriggerType define what closed a bracket
+type TriggerType string
+
+// Global enums
+const (
+	StatePending   State = "PENDING"
+	StateArmed     State = "ARMED"
+	StateTriggered State = "TRIGGERED"
+	StateClosed    State = "CLOSED"
+	StateCancelled State = "CANCELLED"
+	StateFailed    State = "FAILED"
+
+	TriggerTakeProfit   TriggerType = "TAKE_PROFIT"
+	TriggerStopLoss     TriggerType = "STOP_LOSS"
+	TriggerTrailingStop TriggerType = "TRAILING_STOP"
+
+	takeProfitSuffix = "-tp"
+	exitSuffix       = "-x"
+	// client order ids are limited to 36 characters
+	maxIDLength = 36 - len(takeProfitSuffix)
+)
+
+// Bracket define the exit conditions protecting a holding. Side is the side
+// of the exit orders: SELL protects a long holding, BUY a short one. A zero
+// TakeProfit, StopLoss or TrailingDelta disables it. TrailingDelta is a
+// price distance from the best price seen since the bracket was armed.
+type Bracket struct {
+	ID             string           `json:"id"`
+	Symbol         string           `json:"symbol"`
+	Side           binance.SideType `json:"side"`
+	Quantity       common.Decimal   `json:"quantity"`
+	TakeProfit     common.Decimal   `json:"takeProfit"`
+	StopLoss       common.Decimal   `json:"stopLoss"`
+	TrailingDelta  common.Decimal   `json:"trailingDelta"`
+	RestTakeProfit bool             `json:"restTakeProfit"`
+
+	State             State          `json:"state"`
+	Trigger           TriggerType    `json:"trigger,omitempty"`
+	TriggerPrice      common.Decimal `json:"triggerPrice"`
+	Extreme           common.Decimal `json:"extreme"`
+	TakeProfitOrderID int64          `json:"takeProfitOrderId,omitempty"`
+	ExitOrderID       int64          `json:"exitOrderId,omitempty"`
+	Filled            common.Decimal `json:"filled"`
+	// TradeIDs are the trades of the protective orders already in Filled
+	TradeIDs []int64 `json:"tradeIds,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// newTrade record tradeID, it return false if the trade was already applied
+func (b *Bracket) newTrade(tradeID int64) bool {
+	if tradeID <= 0 {
+		return true
+	}
+	for _, id := range b.TradeIDs {
+		if id == tradeID {
+			return false
+		}
+	}
+	b.TradeIDs = append(b.TradeIDs, tradeID)
+	return true
+}
+
+func (b *Bracket) clone() Bracket {
+	cp := *b
+	cp.TradeIDs = append([]int64(nil), b.TradeIDs...)
+	return cp
+}
+
+// TakeProfitClientOrderID return the client order id of the resting take-profit order
+func (b Bracket) TakeProfitClientOrderID() string {
+	return b.ID + takeProfitSuffix
+}
+
+// ExitClientOrderID return the client order id of the exit order
+func (b Bracket) ExitClientOrderID() string {
+	return b.ID + exitSuffix
+}
+
+// Stop return the current stop price, the tighter of StopLoss and the
+// trailing stop, zero if none
+func (b Bracket) Stop() common.Decimal {
+	stop, _ := b.stop()
+	return stop
+}
+
+func (b Bracket) stop() (common.Decimal, TriggerType) {
+	stop, trigger := b.StopLoss, TriggerStopLoss
+	if !b.TrailingDelta.IsPositive() || !b.Extreme.IsPositive() {
+		return stop, trigger
+	}
+	if b.Side == binance.SideTypeSell {
+		if trail := b.Extreme.Sub(b.TrailingDelta); stop.IsZero() || trail.GreaterThan(stop) {
+			return trail, TriggerTrailingStop
+		}
+	} else {
+		if trail := b.Extreme.Add(b.TrailingDelta); stop.IsZero() || trail.LessThan(stop) {
+			return trail, TriggerTrailingStop
+		}
+	}
+	return stop, trigger
+}
+
+func (b Bracket) validate() error {
+	switch {
+	case b.ID == "" || len(b.ID) > maxIDLength:
+		return fmt.Errorf("bracket: id must have 1 to %d characters", maxIDLength)
+	case b.Symbol == "":
+		return errors.New("bracket: symbol not set")
+	case b.Side != binance.SideTypeBuy && b.Side != binance.SideTypeSell:
+		return fmt.Errorf("bracket: invalid side %q", b.Side)
+	case !b.Quantity.IsPositive():
+		return errors.New("bracket: quantity must be positive")
+	case b.TakeProfit.IsNegative() || b.StopLoss.IsNegative() || b.TrailingDelta.IsNegative():
+		return errors.New("bracket: prices must not be negative")
+	case b.TakeProfit.IsZero() && b.StopLoss.IsZero() && b.TrailingDelta.IsZero():
+		return errors.New("bracket: no take-profit, stop-loss or trailing delta")
+	case b.RestTakeProfit && b.TakeProfit.IsZero():
+		return errors.New("bracket: resting take-profit without take-profit price")
+	}
+	if b.TakeProfit.IsPositive() && b.StopLoss.IsPositive() {
+		if b.Side == binance.SideTypeSell && !b.TakeProfit.GreaterThan(b.StopLoss) {
+			return errors.New("bracket: take-profit must be above stop-loss when selling")
+		}
+		if b.Side == binance.SideTypeBuy && !b.TakeProfit.LessThan(b.StopLoss) {
+			return errors.New("bracket: take-profit must be below stop-loss when buying")
+		}
+	}
+	return nil
+}
+
+// observe update the best price seen and report the trigger fired by price, if any
+func (b *Bracket) observe(price common.Decimal) (TriggerType, bool) {
+	sell := b.Side == binance.SideTypeSell
+	if b.Extreme.IsZero() || (sell && price.GreaterThan(b.Extreme)) || (!sell && price.LessThan(b.Extreme)) {
+		b.Extreme = price
+	}
+	if b.TakeProfit.IsPositive() && !b.RestTakeProfit {
+		if (sell && price.GreaterThanOrEqual(b.TakeProfit)) || (!sell && price.LessThanOrEqual(b.TakeProfit)) {
+			return TriggerTakeProfit, true
+		}
+	}
+	stop, trigger := b.stop()
+	if stop.IsPositive() {
+		if (sell && price.LessThanOrEqual(stop)) || (!sell && price.GreaterThanOrEqual(stop)) {
+			return trigger, true
+		}
+	}
+	return "", false
+}
+
+// Manager watch prices and place the protective orders of a set of brackets
+type Manager struct {
+	venue      Venue
+	store      Store
+	errHandler binance.ErrHandler
+
+	mu       sync.Mutex
+	brackets map[string]*Bracket
+	wg       sync.WaitGroup
+}
+
+// NewManager init a manager with the brackets saved in store. Armed
+// brackets are watched again; triggered brackets whose exit order was not
+// placed before the restart are left for Retry.
+func NewManager(venue Venue, store Store) (*Manager, error) {
+	brackets, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	m := &Manager{
+		venue:    venue,
+		store:    store,
+		brackets: make(map[string]*Bracket, len(brackets)),
+	}
+	for _, b := range brackets {
+		m.brackets[b.ID] = b
+	}
+	return m, nil
+}
+
+// OnError set the handler of errors met while handling stream events
+func (m *Manager) OnError(errHandler binance.ErrHandler) *Manager {
+	m.errHandler = errHandler
+	return m
+}
+
+func (m *Manager) handleErr(err error) {
+	if err != nil && m.errHandler != nil {
+		m.errHandler(err)
+	}
+}
+
+func (m *Manager) saveLocked() error {
+	brackets := make([]*Bracket, 0, len(m.brackets))
+	for _, b := range m.brackets {
+		// saved once its take-profit order id is known
+		if b.State == StatePending {
+			continue
+		}
+		cp := b.clone()
+		brackets = append(brackets, &cp)
+	}
+	sort.Slice(brackets, func(i, j int) bool { return brackets[i].ID < brackets[j].ID })
+	return m.store.Save(brackets)
+}
+
+// Add arm a bracket, placing its resting take-profit order if requested
+func (m *Manager) Add(ctx context.Context, b Bracket) error {
+	if err := b.validate(); err != nil {
+		return err
+	}
+	b.State = StateArmed
+	if b.RestTakeProfit {
+		// not watched until the take-profit rests, a trigger in between
+		// would place the exit without cancelling it
+		b.State = StatePending
+	}
+	b.Trigger = ""
+	b.TriggerPrice = common.Decimal{}
+	b.Extreme = common.Decimal{}
+	b.TakeProfitOrderID = 0
+	b.ExitOrderID = 0
+	b.Filled = common.Decimal{}
+	b.TradeIDs = nil
+	b.Error = ""
+
+	m.mu.Lock()
+	if _, ok := m.brackets[b.ID]; ok {
+		m.mu.Unlock()
+		return fmt.Errorf("bracket: %s already exists", b.ID)
+	}
+	// register first, the order reports may arrive before the response
+	m.brackets[b.ID] = &b
+	m.mu.Unlock()
+
+	if b.RestTakeProfit {
+		orderID, err := m.venue.PlaceOrder(ctx, &Order{
+			Symbol:        b.Symbol,
+			Side:          b.Side,
+			Type:          binance.OrderTypeLimit,
+			Price:         b.TakeProfit,
+			Quantity:      b.Quantity,
+			ClientOrderID: b.TakeProfitClientOrderID(),
+		})
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if err != nil {
+			delete(m.brackets, b.ID)
+			return err
+		}
+		pb := m.brackets[b.ID]
+		// the take-profit may already have filled or been cancelled
+		if pb.RestTakeProfit {
+			pb.TakeProfitOrderID = orderID
+		}
+		if pb.State == StatePending {
+			pb.State = StateArmed
+		}
+		return m.saveLocked()
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.saveLocked()
+}
+
+// Cancel disarm a bracket and cancel its resting take-profit order
+func (m *Manager) Cancel(ctx context.Context, id string) error {
+	m.mu.Lock()
+	b, ok := m.brackets[id]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("bracket: %s not found", id)
+	}
+	if b.State != StateArmed {
+		m.mu.Unlock()
+		return fmt.Errorf("bracket: cannot cancel %s bracket %s", b.State, id)
+	}
+	b.State = StateCancelled
+	symbol, orderID := b.Symbol, b.TakeProfitOrderID
+	err := m.saveLocked()
+	m.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if orderID != 0 {
+		_, err = m.venue.CancelOrder(ctx, symbol, orderID)
+		return err
+	}
+	return nil
+}
+
+// Retry place the exit order of a failed bracket, or of a triggered one
+// whose exit was interrupted by a restart
+func (m *Manager) Retry(ctx context.Context, id string) error {
+	m.mu.Lock()
+	b, ok := m.brackets[id]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("bracket: %s not found", id)
+	}
+	if b.State != StateFailed && !(b.State == StateTriggered && b.ExitOrderID == 0) {
+		m.mu.Unlock()
+		return fmt.Errorf("bracket: cannot retry %s bracket %s", b.State, id)
+	}
+	b.State = StateTriggered
+	b.ExitOrderID = 0
+	b.Error = ""
+	m.mu.Unlock()
+	return m.exit(ctx, id)
+}
+
+// Bracket return a copy of a bracket
+func (m *Manager) Bracket(id string) (Bracket, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b, ok := m.brackets[id]
+	if !ok {
+		return Bracket{}, false
+	}
+	return b.clone(), true
+}
+
+// Brackets return a copy of every bracket, sorted by id
+func (m *Manager) Brackets() []Bracket {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := make([]Bracket, 0, len(m.brackets))
+	for _, b := range m.brackets {
+		res = append(res, b.clone())
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+// Wait block until the exits in flight are done
+func (m *Manager) Wait() {
+	m.wg.Wait()
+}
+
+// HandleBookTicker check the brackets of the symbol against the best bid
+// (SELL exits) or the best ask (BUY exits)
+func (m *Manager) HandleBookTicker(e *binance.WsBookTickerEvent) {
+	bid, err := common.ParseDecimal(e.BestBidPrice)
+	if err != nil {
+		m.handleErr(err)
+		return
+	}
+	ask, err := common.ParseDecimal(e.BestAskPrice)
+	if err != nil {
+		m.handleErr(err)
+		return
+	}
+	m.observe(e.Symbol, bid, ask)
+}
+
+// HandleTrade check the brackets of the symbol against the trade price
+func (m *Manager) HandleTrade(e *binance.WsTradeEvent) {
+	price, err := common.ParseDecimal(e.Price)
+	if err != nil {
+		m.handleErr(err)
+		return
+	}
+	m.observe(e.Symbol, price, price)
+}
+
+// ServeBookTicker watch the book ticker of symbol
+func (m *Manager) ServeBookTicker(symbol string) (doneC, stopC chan struct{}, err error) {
+	return binance.WsBookTickerServe(symbol, m.HandleBookTicker, m.handleErr)
+}
+
+// ServeTrade watch the trades of symbol
+func (m *Manager) ServeTrade(symbol string) (doneC, stopC chan struct{}, err error) {
+	return binance.WsTradeServe(symbol, m.HandleTrade, m.handleErr)
+}
+
+func (m *Manager) observe(symbol string, bid, ask common.Decimal) {
+	var triggered []string
+	m.mu.Lock()
+	changed := false
+	for _, b := range m.brackets {
+		if b.Symbol != symbol || b.State != StateArmed {
+			continue
+		}
+		price := ask
+		if b.Side == binance.SideTypeSell {
+			price = bid
+		}
+		if !price.IsPositive() {
+			continue
+		}
+		extreme := b.Extreme
+		trigger, ok := b.observe(price)
+		if !ok {
+			changed = changed || (b.TrailingDelta.IsPositive() && !extreme.Equal(b.Extreme))
+			continue
+		}
+		b.State = StateTriggered
+		b.Trigger = trigger
+		b.TriggerPrice = price
+		triggered = append(triggered, b.ID)
+		changed = true
+	}
+	var err error
+	if changed {
+		err = m.saveLocked()
+	}
+	m.mu.Unlock()
+	m.handleErr(err)
+
+	for _, id := range triggered {
+		m.wg.Add(1)
+		go func(id string) {
+			defer m.wg.Done()
+			m.handleErr(m.exit(context.Background(), id))
+		}(id)
+	}
+}
+
+// exit cancel the resting take-profit and place the MARKET exit order
+func (m *Manager) exit(ctx context.Context, id string) error {
+	m.mu.Lock()
+	b := m.brackets[id]
+	if b.State != StateTriggered || b.ExitOrderID != 0 {
+		m.mu.Unlock()
+		return nil
+	}
+	symbol, takeProfitOrderID := b.Symbol, b.TakeProfitOrderID
+	m.mu.Unlock()
+
+	var executed common.Decimal
+	if takeProfitOrderID != 0 {
+		var err error
+		executed, err = m.venue.CancelOrder(ctx, symbol, takeProfitOrderID)
+		if err != nil {
+			// the take-profit may have just filled, its report closes the bracket
+			return m.fail(id, fmt.Errorf("bracket %s: cancel take-profit: %w", id, err))
+		}
+	}
+
+	m.mu.Lock()
+	if b.State != StateTriggered {
+		m.mu.Unlock()
+		return nil
+	}
+	// the fill reports of the take-profit may lag behind the cancel response
+	quantity := b.Quantity.Sub(common.MaxDecimal(b.Filled, executed))
+	if !quantity.IsPositive() {
+		m.mu.Unlock()
+		return nil
+	}
+	o := &Order{
+		Symbol:        b.Symbol,
+		Side:          b.Side,
+		Type:          binance.OrderTypeMarket,
+		Quantity:      quantity,
+		ClientOrderID: b.ExitClientOrderID(),
+	}
+	m.mu.Unlock()
+
+	orderID, err := m.venue.PlaceOrder(ctx, o)
+	if err != nil {
+		return m.fail(id, fmt.Errorf("bracket %s: place exit: %w", id, err))
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if b.ExitOrderID == 0 {
+		b.ExitOrderID = orderID
+	}
+	return m.saveLocked()
+}
+
+func (m *Manager) fail(id string, err error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b := m.brackets[id]
+	if b.State != StateTriggered {
+		return nil
+	}
+	b.State = StateFailed
+	b.Error = err.Error()
+	if serr := m.saveLocked(); serr != nil {
+		return serr
+	}
+	return err
+}
+
+// HandleUserData apply the execution reports of the protective orders
+func (m *Manager) HandleUserData(e *binance.WsUserDataEvent) {
+	if e.Event != binance.UserDataEventTypeExecutionReport {
+		return
+	}
+	u := e.OrderUpdate
+	clientOrderID := u.ClientOrderId
+	// a cancel report carries the cancelled id in C
+	if u.OrigCustomOrderId != "" {
+		clientOrderID = u.OrigCustomOrderId
+	}
+	var id string
+	takeProfit := strings.HasSuffix(clientOrderID, takeProfitSuffix)
+	switch {
+	case takeProfit:
+		id = strings.TrimSuffix(clientOrderID, takeProfitSuffix)
+	case strings.HasSuffix(clientOrderID, exitSuffix):
+		id = strings.TrimSuffix(clientOrderID, exitSuffix)
+	default:
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b, ok := m.brackets[id]
+	if !ok || b.State == StateClosed {
+		return
+	}
+	if u.LatestVolume != "" {
+		filled, err := common.ParseDecimal(u.LatestVolume)
+		if err != nil {
+			m.handleErr(err)
+			return
+		}
+		if filled.IsPositive() && b.newTrade(u.TradeId) {
+			// a duplicated report without trade id cannot overfill the bracket
+			b.Filled = common.MinDecimal(b.Filled.Add(filled), b.Quantity)
+		}
+	}
+	switch u.Status {
+	case string(binance.OrderStatusTypeFilled):
+		if takeProfit && b.Trigger == "" {
+			b.Trigger = TriggerTakeProfit
+			b.TriggerPrice = b.TakeProfit
+		}
+		b.State = StateClosed
+		b.Error = ""
+	case string(binance.OrderStatusTypeCanceled), string(binance.OrderStatusTypeExpired),
+		string(binance.OrderStatusTypeRejected):
+		if takeProfit {
+			// gone without us, watch the take-profit price locally instead
+			if b.State == StateArmed || b.State == StatePending {
+				b.TakeProfitOrderID = 0
+				b.RestTakeProfit = false
+			}
+			break
+		}
+		if b.State == StateTriggered {
+			b.State = StateFailed
+			b.Error = fmt.Sprintf("exit order %s", u.Status)
+		}
+	}
+	m.handleErr(m.saveLocked())
+}
@@ -0,0 +1,424 @@
+package bracket
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeVenue struct {
+	mu        sync.Mutex
+	err       error
+	orders    []*Order
+	cancelled []int64
+	executed  common.Decimal
+	onPlace   func(o *Order)
+}
+
+func (v *fakeVenue) PlaceOrder(ctx context.Context, o *Order) (int64, error) {
+	if v.onPlace != nil {
+		v.onPlace(o)
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.err != nil {
+		return 0, v.err
+	}
+	cp := *o
+	v.orders = append(v.orders, &cp)
+	return int64(len(v.orders)), nil
+}
+
+func (v *fakeVenue) CancelOrder(ctx context.Context, symbol string, orderID int64) (common.Decimal, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.cancelled = append(v.cancelled, orderID)
+	return v.executed, nil
+}
+
+type bracketTestSuite struct {
+	suite.Suite
+	venue *fakeVenue
+	store *FileStore
+	m     *Manager
+	errs  []error
+}
+
+func TestBracket(t *testing.T) {
+	suite.Run(t, new(bracketTestSuite))
+}
+
+func (s *bracketTestSuite) SetupTest() {
+	s.venue = &fakeVenue{}
+	s.store = NewFileStore(filepath.Join(s.T().TempDir(), "brackets.json"))
+	s.errs = nil
+	var err error
+	s.m, err = NewManager(s.venue, s.store)
+	s.Require().NoError(err)
+	s.m.OnError(func(err error) { s.errs = append(s.errs, err) })
+}
+
+func dec(s string) common.Decimal {
+	return common.MustParseDecimal(s)
+}
+
+func (s *bracketTestSuite) trade(price string) {
+	s.m.HandleTrade(&binance.WsTradeEvent{Symbol: "BTCUSDT", Price: price})
+	s.m.Wait()
+}
+
+func (s *bracketTestSuite) report(clientOrderID, status, lastQty string) {
+	s.m.HandleUserData(&binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeExecutionReport,
+		OrderUpdate: binance.WsOrderUpdate{
+			Symbol:        "BTCUSDT",
+			ClientOrderId: clientOrderID,
+			Status:        status,
+			LatestVolume:  lastQty,
+		},
+	})
+}
+
+func (s *bracketTestSuite) get(id string) Bracket {
+	b, ok := s.m.Bracket(id)
+	s.Require().True(ok)
+	return b
+}
+
+func (s *bracketTestSuite) TestStopLoss() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:         "b1",
+		Symbol:     "BTCUSDT",
+		Side:       binance.SideTypeSell,
+		Quantity:   dec("1"),
+		TakeProfit: dec("110"),
+		StopLoss:   dec("95"),
+	}))
+	s.trade("100")
+	s.Equal(StateArmed, s.get("b1").State)
+	s.Empty(s.venue.orders)
+
+	s.trade("94.9")
+	b := s.get("b1")
+	s.Equal(StateTriggered, b.State)
+	s.Equal(TriggerStopLoss, b.Trigger)
+	s.True(b.TriggerPrice.Equal(dec("94.9")))
+	s.Equal(int64(1), b.ExitOrderID)
+	s.Require().Len(s.venue.orders, 1)
+	s.Equal(&Order{
+		Symbol:        "BTCUSDT",
+		Side:          binance.SideTypeSell,
+		Type:          binance.OrderTypeMarket,
+		Quantity:      dec("1"),
+		ClientOrderID: "b1-x",
+	}, s.venue.orders[0])
+
+	// a later price does not trigger it again
+	s.trade("90")
+	s.Len(s.venue.orders, 1)
+
+	s.report("b1-x", "FILLED", "1")
+	b = s.get("b1")
+	s.Equal(StateClosed, b.State)
+	s.True(b.Filled.Equal(dec("1")))
+	s.Empty(s.errs)
+}
+
+func (s *bracketTestSuite) TestTakeProfitOnBookTicker() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:         "b1",
+		Symbol:     "BTCUSDT",
+		Side:       binance.SideTypeBuy,
+		Quantity:   dec("2"),
+		TakeProfit: dec("90"),
+		StopLoss:   dec("110"),
+	}))
+	// a BUY exit watches the ask
+	s.m.HandleBookTicker(&binance.WsBookTickerEvent{Symbol: "BTCUSDT", BestBidPrice: "89", BestAskPrice: "90.5"})
+	s.m.Wait()
+	s.Equal(StateArmed, s.get("b1").State)
+
+	s.m.HandleBookTicker(&binance.WsBookTickerEvent{Symbol: "BTCUSDT", BestBidPrice: "88.5", BestAskPrice: "89.5"})
+	s.m.Wait()
+	b := s.get("b1")
+	s.Equal(StateTriggered, b.State)
+	s.Equal(TriggerTakeProfit, b.Trigger)
+	s.Equal(binance.SideTypeBuy, s.venue.orders[0].Side)
+}
+
+func (s *bracketTestSuite) TestTrailingStop() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:            "b1",
+		Symbol:        "BTCUSDT",
+		Side:          binance.SideTypeSell,
+		Quantity:      dec("1"),
+		StopLoss:      dec("95"),
+		TrailingDelta: dec("5"),
+	}))
+	s.trade("100")
+	// the stop-loss is tighter than the trailing stop at 95 - 5
+	s.True(s.get("b1").Stop().Equal(dec("95")))
+	s.trade("110")
+	s.True(s.get("b1").Stop().Equal(dec("105")))
+	s.trade("106")
+	s.Equal(StateArmed, s.get("b1").State)
+
+	// the best price survives a restart
+	m, err := NewManager(s.venue, s.store)
+	s.Require().NoError(err)
+	b, ok := m.Bracket("b1")
+	s.Require().True(ok)
+	s.True(b.Extreme.Equal(dec("110")))
+
+	s.trade("104.9")
+	b = s.get("b1")
+	s.Equal(StateTriggered, b.State)
+	s.Equal(TriggerTrailingStop, b.Trigger)
+}
+
+func (s *bracketTestSuite) TestRestingTakeProfit() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:             "b1",
+		Symbol:         "BTCUSDT",
+		Side:           binance.SideTypeSell,
+		Quantity:       dec("1"),
+		TakeProfit:     dec("110"),
+		StopLoss:       dec("95"),
+		RestTakeProfit: true,
+	}))
+	s.Require().Len(s.venue.orders, 1)
+	s.Equal(&Order{
+		Symbol:        "BTCUSDT",
+		Side:          binance.SideTypeSell,
+		Type:          binance.OrderTypeLimit,
+		Price:         dec("110"),
+		Quantity:      dec("1"),
+		ClientOrderID: "b1-tp",
+	}, s.venue.orders[0])
+	s.Equal(int64(1), s.get("b1").TakeProfitOrderID)
+
+	// the resting order takes care of the take-profit
+	s.trade("111")
+	s.Equal(StateArmed, s.get("b1").State)
+
+	s.report("b1-tp", "PARTIALLY_FILLED", "0.4")
+	s.trade("94")
+	s.Equal([]int64{1}, s.venue.cancelled)
+	s.Require().Len(s.venue.orders, 2)
+	s.True(s.venue.orders[1].Quantity.Equal(dec("0.6")))
+	s.Equal(binance.OrderTypeMarket, s.venue.orders[1].Type)
+
+	s.report("b1-tp", "CANCELED", "0")
+	s.Equal(StateTriggered, s.get("b1").State)
+}
+
+func (s *bracketTestSuite) TestRestingTakeProfitFillsNotReported() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:             "b1",
+		Symbol:         "BTCUSDT",
+		Side:           binance.SideTypeSell,
+		Quantity:       dec("1"),
+		TakeProfit:     dec("110"),
+		StopLoss:       dec("95"),
+		RestTakeProfit: true,
+	}))
+	// the take-profit executed 0.7 but only 0.2 was reported yet
+	s.report("b1-tp", "PARTIALLY_FILLED", "0.2")
+	s.venue.executed = dec("0.7")
+	s.trade("94")
+	s.Require().Len(s.venue.orders, 2)
+	s.True(s.venue.orders[1].Quantity.Equal(dec("0.3")))
+}
+
+func (s *bracketTestSuite) TestDuplicatedFillReport() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:             "b1",
+		Symbol:         "BTCUSDT",
+		Side:           binance.SideTypeSell,
+		Quantity:       dec("1"),
+		TakeProfit:     dec("110"),
+		StopLoss:       dec("95"),
+		RestTakeProfit: true,
+	}))
+	fill := &binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeExecutionReport,
+		OrderUpdate: binance.WsOrderUpdate{
+			Symbol:        "BTCUSDT",
+			ClientOrderId: "b1-tp",
+			Status:        "PARTIALLY_FILLED",
+			LatestVolume:  "0.4",
+			TradeId:       7,
+		},
+	}
+	s.m.HandleUserData(fill)
+	// replayed after a reconnect
+	s.m.HandleUserData(fill)
+	s.True(s.get("b1").Filled.Equal(dec("0.4")))
+
+	s.trade("94")
+	s.Require().Len(s.venue.orders, 2)
+	s.True(s.venue.orders[1].Quantity.Equal(dec("0.6")))
+}
+
+func (s *bracketTestSuite) TestPendingUntilTakeProfitRests() {
+	var state State
+	s.venue.onPlace = func(o *Order) {
+		if o.Type != binance.OrderTypeLimit {
+			return
+		}
+		// a trigger while the take-profit is being placed
+		s.trade("94")
+		state = s.get("b1").State
+	}
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:             "b1",
+		Symbol:         "BTCUSDT",
+		Side:           binance.SideTypeSell,
+		Quantity:       dec("1"),
+		TakeProfit:     dec("110"),
+		StopLoss:       dec("95"),
+		RestTakeProfit: true,
+	}))
+	s.Equal(StatePending, state)
+	s.Require().Len(s.venue.orders, 1)
+	b := s.get("b1")
+	s.Equal(StateArmed, b.State)
+	s.Equal(int64(1), b.TakeProfitOrderID)
+
+	s.trade("94")
+	s.Equal([]int64{1}, s.venue.cancelled)
+	s.Require().Len(s.venue.orders, 2)
+	s.Equal(binance.OrderTypeMarket, s.venue.orders[1].Type)
+}
+
+func (s *bracketTestSuite) TestRestingTakeProfitFilled() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:             "b1",
+		Symbol:         "BTCUSDT",
+		Side:           binance.SideTypeSell,
+		Quantity:       dec("1"),
+		TakeProfit:     dec("110"),
+		RestTakeProfit: true,
+	}))
+	s.report("b1-tp", "FILLED", "1")
+	b := s.get("b1")
+	s.Equal(StateClosed, b.State)
+	s.Equal(TriggerTakeProfit, b.Trigger)
+}
+
+func (s *bracketTestSuite) TestRestingTakeProfitCancelledOutside() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:             "b1",
+		Symbol:         "BTCUSDT",
+		Side:           binance.SideTypeSell,
+		Quantity:       dec("1"),
+		TakeProfit:     dec("110"),
+		RestTakeProfit: true,
+	}))
+	s.m.HandleUserData(&binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeExecutionReport,
+		OrderUpdate: binance.WsOrderUpdate{
+			ClientOrderId:     "web_123",
+			OrigCustomOrderId: "b1-tp",
+			Status:            "CANCELED",
+		},
+	})
+	b := s.get("b1")
+	s.False(b.RestTakeProfit)
+	s.Zero(b.TakeProfitOrderID)
+
+	s.trade("110")
+	s.Equal(TriggerTakeProfit, s.get("b1").Trigger)
+}
+
+func (s *bracketTestSuite) TestExitFailureAndRetry() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:       "b1",
+		Symbol:   "BTCUSDT",
+		Side:     binance.SideTypeSell,
+		Quantity: dec("1"),
+		StopLoss: dec("95"),
+	}))
+	s.venue.err = errors.New("insufficient balance")
+	s.trade("90")
+	b := s.get("b1")
+	s.Equal(StateFailed, b.State)
+	s.Contains(b.Error, "insufficient balance")
+	s.Len(s.errs, 1)
+
+	s.venue.err = nil
+	s.Require().NoError(s.m.Retry(context.Background(), "b1"))
+	b = s.get("b1")
+	s.Equal(StateTriggered, b.State)
+	s.Equal(int64(1), b.ExitOrderID)
+	s.Error(s.m.Retry(context.Background(), "b1"))
+}
+
+func (s *bracketTestSuite) TestRetryAfterRestart() {
+	s.Require().NoError(s.store.Save([]*Bracket{{
+		ID:       "b1",
+		Symbol:   "BTCUSDT",
+		Side:     binance.SideTypeSell,
+		Quantity: dec("1"),
+		StopLoss: dec("95"),
+		State:    StateTriggered,
+		Trigger:  TriggerStopLoss,
+	}}))
+	m, err := NewManager(s.venue, s.store)
+	s.Require().NoError(err)
+	s.Require().NoError(m.Retry(context.Background(), "b1"))
+	s.Require().Len(s.venue.orders, 1)
+	s.Equal("b1-x", s.venue.orders[0].ClientOrderID)
+}
+
+func (s *bracketTestSuite) TestCancel() {
+	s.Require().NoError(s.m.Add(context.Background(), Bracket{
+		ID:             "b1",
+		Symbol:         "BTCUSDT",
+		Side:           binance.SideTypeSell,
+		Quantity:       dec("1"),
+		TakeProfit:     dec("110"),
+		RestTakeProfit: true,
+	}))
+	s.Require().NoError(s.m.Cancel(context.Background(), "b1"))
+	s.Equal(StateCancelled, s.get("b1").State)
+	s.Equal([]int64{1}, s.venue.cancelled)
+	s.trade("120")
+	s.Len(s.venue.orders, 1)
+	s.Error(s.m.Cancel(context.Background(), "b1"))
+	s.Error(s.m.Cancel(context.Background(), "b2"))
+}
+
+func (s *bracketTestSuite) TestValidate() {
+	valid := Bracket{
+		ID:       "b1",
+		Symbol:   "BTCUSDT",
+		Side:     binance.SideTypeSell,
+		Quantity: dec("1"),
+		StopLoss: dec("95"),
+	}
+	s.Require().NoError(s.m.Add(context.Background(), valid))
+	s.Error(s.m.Add(context.Background(), valid))
+
+	for _, f := range []func(b *Bracket){
+		func(b *Bracket) { b.ID = "" },
+		func(b *Bracket) { b.ID = "a-bracket-id-far-too-long-for-binance" },
+		func(b *Bracket) { b.Side = "HOLD" },
+		func(b *Bracket) { b.Quantity = dec("0") },
+		func(b *Bracket) { b.StopLoss = common.Decimal{} },
+		func(b *Bracket) { b.TakeProfit = dec("90") },
+		func(b *Bracket) { b.RestTakeProfit = true },
+	} {
+		b := valid
+		b.ID = "b2"
+		f(&b)
+		s.Error(s.m.Add(context.Background(), b))
+	}
+	s.Len(s.m.Brackets(), 1)
+}
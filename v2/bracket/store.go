@@ -0,0 +1,51 @@
+package bracket
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Store persist the brackets of a manager so that it survives restarts
+type Store interface {
+	Load() ([]*Bracket, error)
+	Save(brackets []*Bracket) error
+}
+
+// FileStore keep brackets in a JSON file, replaced atomically on every save
+type FileStore struct {
+	path string
+}
+
+// NewFileStore init a store writing to path
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load read the brackets, none if the file does not exist yet
+func (s *FileStore) Load() ([]*Bracket, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var brackets []*Bracket
+	if err = json.Unmarshal(data, &brackets); err != nil {
+		return nil, err
+	}
+	return brackets, nil
+}
+
+// Save write the brackets to a temporary file renamed over the previous one
+func (s *FileStore) Save(brackets []*Bracket) error {
+	data, err := json.MarshalIndent(brackets, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
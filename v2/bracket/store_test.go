@@ -0,0 +1,60 @@
+package bracket
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/stretchr/testify/suite"
+)
+
+type storeTestSuite struct {
+	suite.Suite
+}
+
+func TestStore(t *testing.T) {
+	suite.Run(t, new(storeTestSuite))
+}
+
+func (s *storeTestSuite) TestFileStore() {
+	path := filepath.Join(s.T().TempDir(), "brackets.json")
+	store := NewFileStore(path)
+
+	brackets, err := store.Load()
+	s.Require().NoError(err)
+	s.Empty(brackets)
+
+	saved := []*Bracket{{
+		ID:                "b1",
+		Symbol:            "BTCUSDT",
+		Side:              binance.SideTypeSell,
+		Quantity:          dec("0.015"),
+		TakeProfit:        dec("31000.5"),
+		StopLoss:          dec("29000"),
+		RestTakeProfit:    true,
+		State:             StateArmed,
+		TakeProfitOrderID: 28,
+	}}
+	s.Require().NoError(store.Save(saved))
+	_, err = os.Stat(path + ".tmp")
+	s.True(os.IsNotExist(err))
+
+	brackets, err = store.Load()
+	s.Require().NoError(err)
+	s.Require().Len(brackets, 1)
+	b := brackets[0]
+	s.Equal("b1", b.ID)
+	s.Equal(binance.SideTypeSell, b.Side)
+	s.True(b.Quantity.Equal(dec("0.015")))
+	s.True(b.TakeProfit.Equal(dec("31000.5")))
+	s.True(b.StopLoss.Equal(dec("29000")))
+	s.True(b.TrailingDelta.IsZero())
+	s.True(b.RestTakeProfit)
+	s.Equal(StateArmed, b.State)
+	s.Equal(int64(28), b.TakeProfitOrderID)
+
+	s.Require().NoError(os.WriteFile(path, []byte("{"), 0o600))
+	_, err = store.Load()
+	s.Error(err)
+}
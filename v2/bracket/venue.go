@@ -0,0 +1,111 @@
+package bracket
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Order define a protective order placed by the manager, a resting LIMIT
+// take-profit or a MARKET exit
+type Order struct {
+	Symbol        string
+	Side          binance.SideType
+	Type          binance.OrderType
+	Price         common.Decimal
+	Quantity      common.Decimal
+	ClientOrderID string
+}
+
+// Venue place and cancel protective orders. CancelOrder return the quantity
+// the order executed before it was cancelled.
+type Venue interface {
+	PlaceOrder(ctx context.Context, o *Order) (orderID int64, err error)
+	CancelOrder(ctx context.Context, symbol string, orderID int64) (executed common.Decimal, err error)
+}
+
+// SpotVenue place protective orders on the spot account
+type SpotVenue struct {
+	c *binance.Client
+}
+
+// NewSpotVenue init a venue trading on the spot account
+func NewSpotVenue(c *binance.Client) *SpotVenue {
+	return &SpotVenue{c: c}
+}
+
+// PlaceOrder place a spot order
+func (v *SpotVenue) PlaceOrder(ctx context.Context, o *Order) (int64, error) {
+	s := v.c.NewCreateOrderService().Symbol(o.Symbol).Side(o.Side).Type(o.Type).
+		QuantityDecimal(o.Quantity).NewClientOrderID(o.ClientOrderID)
+	if o.Type == binance.OrderTypeLimit {
+		s.TimeInForce(binance.TimeInForceTypeGTC).PriceDecimal(o.Price)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.OrderID, nil
+}
+
+// CancelOrder cancel a spot order
+func (v *SpotVenue) CancelOrder(ctx context.Context, symbol string, orderID int64) (common.Decimal, error) {
+	res, err := v.c.NewCancelOrderService().Symbol(symbol).OrderID(orderID).Do(ctx)
+	if err != nil {
+		return common.Decimal{}, err
+	}
+	return parseExecuted(res.ExecutedQuantity)
+}
+
+// MarginVenue place protective orders on the cross or isolated margin account
+type MarginVenue struct {
+	c          *binance.Client
+	isIsolated bool
+	sideEffect *binance.SideEffectType
+}
+
+// NewMarginVenue init a venue trading on the cross or isolated margin account
+func NewMarginVenue(c *binance.Client, isIsolated bool) *MarginVenue {
+	return &MarginVenue{c: c, isIsolated: isIsolated}
+}
+
+// SideEffectType set the side effect of the orders, such as AUTO_REPAY
+func (v *MarginVenue) SideEffectType(sideEffect binance.SideEffectType) *MarginVenue {
+	v.sideEffect = &sideEffect
+	return v
+}
+
+// PlaceOrder place a margin order
+func (v *MarginVenue) PlaceOrder(ctx context.Context, o *Order) (int64, error) {
+	s := v.c.NewCreateMarginOrderService().Symbol(o.Symbol).IsIsolated(v.isIsolated).
+		Side(o.Side).Type(o.Type).QuantityDecimal(o.Quantity).NewClientOrderID(o.ClientOrderID)
+	if o.Type == binance.OrderTypeLimit {
+		s.TimeInForce(binance.TimeInForceTypeGTC).PriceDecimal(o.Price)
+	}
+	if v.sideEffect != nil {
+		s.SideEffectType(*v.sideEffect)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.OrderID, nil
+}
+
+// CancelOrder cancel a margin order
+func (v *MarginVenue) CancelOrder(ctx context.Context, symbol string, orderID int64) (common.Decimal, error) {
+	res, err := v.c.NewCancelMarginOrderService().Symbol(symbol).IsIsolated(v.isIsolated).
+		OrderID(orderID).Do(ctx)
+	if err != nil {
+		return common.Decimal{}, err
+	}
+	return parseExecuted(res.ExecutedQuantity)
+}
+
+func parseExecuted(s string) (common.Decimal, error) {
+	if s == "" {
+		return common.Decimal{}, nil
+	}
+	return common.ParseDecimal(s)
+}
@@ -0,0 +1,103 @@
+package bracket
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/stretchr/testify/suite"
+)
+
+type venueTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	method string
+	path   string
+	form   url.Values
+}
+
+func TestVenue(t *testing.T) {
+	suite.Run(t, new(venueTestSuite))
+}
+
+func (s *venueTestSuite) SetupTest() {
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.method = r.Method
+		s.path = r.URL.Path
+		s.form, _ = url.ParseQuery(string(body))
+		for k, v := range r.URL.Query() {
+			s.form[k] = v
+		}
+		if r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/sapi") {
+			// the margin cancel response quotes the order id
+			w.Write([]byte(`{"orderId": "28", "symbol": "BTCUSDT", "executedQty": "0.005"}`))
+			return
+		}
+		w.Write([]byte(`{"orderId": 28, "symbol": "BTCUSDT"}`))
+	}))
+}
+
+func (s *venueTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *venueTestSuite) client() *binance.Client {
+	c := binance.NewClient("key", "secret")
+	c.BaseURL = s.server.URL
+	return c
+}
+
+func (s *venueTestSuite) TestSpotVenue() {
+	v := NewSpotVenue(s.client())
+	orderID, err := v.PlaceOrder(context.Background(), &Order{
+		Symbol:        "BTCUSDT",
+		Side:          binance.SideTypeSell,
+		Type:          binance.OrderTypeLimit,
+		Price:         dec("31000.5"),
+		Quantity:      dec("0.015"),
+		ClientOrderID: "b1-tp",
+	})
+	s.Require().NoError(err)
+	s.Equal(int64(28), orderID)
+	s.Equal("/api/v3/order", s.path)
+	s.Equal("LIMIT", s.form.Get("type"))
+	s.Equal("GTC", s.form.Get("timeInForce"))
+	s.Equal("31000.5", s.form.Get("price"))
+	s.Equal("0.015", s.form.Get("quantity"))
+	s.Equal("b1-tp", s.form.Get("newClientOrderId"))
+
+	executed, err := v.CancelOrder(context.Background(), "BTCUSDT", 28)
+	s.Require().NoError(err)
+	s.True(executed.IsZero())
+	s.Equal(http.MethodDelete, s.method)
+	s.Equal("28", s.form.Get("orderId"))
+}
+
+func (s *venueTestSuite) TestMarginVenue() {
+	v := NewMarginVenue(s.client(), false).SideEffectType(binance.SideEffectTypeAutoRepay)
+	_, err := v.PlaceOrder(context.Background(), &Order{
+		Symbol:        "BTCUSDT",
+		Side:          binance.SideTypeBuy,
+		Type:          binance.OrderTypeMarket,
+		Quantity:      dec("0.015"),
+		ClientOrderID: "b1-x",
+	})
+	s.Require().NoError(err)
+	s.Equal("/sapi/v1/margin/order", s.path)
+	s.Equal("MARKET", s.form.Get("type"))
+	s.Equal("", s.form.Get("timeInForce"))
+	s.Equal("", s.form.Get("price"))
+	s.Equal("AUTO_REPAY", s.form.Get("sideEffectType"))
+
+	executed, err := v.CancelOrder(context.Background(), "BTCUSDT", 28)
+	s.Require().NoError(err)
+	s.True(executed.Equal(dec("0.005")))
+	s.Equal("/sapi/v1/margin/order", s.path)
+	s.Equal(http.MethodDelete, s.method)
+}
@@ -102,6 +102,47 @@ type RateLimitInterval string
 // AccountType define the account types
 type AccountType string
 
+// WindowSize define the window of rolling window price change statistics:
+// 1m to 59m, 1h to 23h or 1d to 7d. Streams only support 1h, 4h and 1d.
+type WindowSize string
+
+// TickerType define the verbosity of ticker statistics (FULL or MINI)
+type TickerType string
+
+// CancelReplaceModeType define the behavior of cancel-replace when the cancel fails
+type CancelReplaceModeType string
+
+// CancelReplaceResultType define the outcome of each half of a cancel-replace
+type CancelReplaceResultType string
+
+// CancelRestrictionsType define the order status required for a cancel to succeed
+type CancelRestrictionsType string
+
+// OrderRateLimitExceededModeType define the behavior of cancel-replace when the unfilled order count is exceeded
+type OrderRateLimitExceededModeType string
+
+// STPModeType define the self-trade prevention mode, which decides the
+// orders expired when orders of the same account or trade group would match
+type STPModeType string
+
+// ContingencyType define the type of an order list
+type ContingencyType string
+
+// ListStatusType define the status of an order list
+type ListStatusType string
+
+// ListOrderStatusType define the status of the orders of an order list
+type ListOrderStatusType string
+
+// AlgoUrgencyType define the urgency of a volume participation algo order
+type AlgoUrgencyType string
+
+// AlgoStatusType define the status of an algo order
+type AlgoStatusType string
+
+// AlgoPositionSideType define the futures position side of an algo order
+type AlgoPositionSideType string
+
 // Endpoints
 const (
 	baseAPIMainURL    = "https://api.binance.com"
@@ -142,7 +183,10 @@ const (
 	OrderStatusTypePendingCancel   OrderStatusType = "PENDING_CANCEL"
 	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
 	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
-	OrderStatusExpiredInMatch      OrderStatusType = "EXPIRED_IN_MATCH" // STP Expired
+	OrderStatusTypeExpiredInMatch  OrderStatusType = "EXPIRED_IN_MATCH" // expired by self-trade prevention
+
+	// Deprecated: use OrderStatusTypeExpiredInMatch
+	OrderStatusExpiredInMatch = OrderStatusTypeExpiredInMatch
 
 	SymbolTypeSpot SymbolType = "SPOT"
 
@@ -168,7 +212,7 @@ const (
 	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
 	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
 	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
-	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
+	UserDataEventTypeListStatus              UserDataEventType = "listStatus"
 
 	MarginTransferTypeToMargin MarginTransferType = 1
 	MarginTransferTypeToMain   MarginTransferType = 2
@@ -239,6 +283,56 @@ const (
 	AccountTypeIsolatedMargin AccountType = "ISOLATED_MARGIN"
 	AccountTypeUSDTFuture     AccountType = "USDT_FUTURE"
 	AccountTypeCoinFuture     AccountType = "COIN_FUTURE"
+
+	WindowSize1h WindowSize = "1h"
+	WindowSize4h WindowSize = "4h"
+	WindowSize1d WindowSize = "1d"
+
+	TickerTypeFull TickerType = "FULL"
+	TickerTypeMini TickerType = "MINI"
+
+	CancelReplaceModeStopOnFailure CancelReplaceModeType = "STOP_ON_FAILURE"
+	CancelReplaceModeAllowFailure  CancelReplaceModeType = "ALLOW_FAILURE"
+
+	CancelReplaceResultSuccess      CancelReplaceResultType = "SUCCESS"
+	CancelReplaceResultFailure      CancelReplaceResultType = "FAILURE"
+	CancelReplaceResultNotAttempted CancelReplaceResultType = "NOT_ATTEMPTED"
+
+	CancelRestrictionsOnlyNew             CancelRestrictionsType = "ONLY_NEW"
+	CancelRestrictionsOnlyPartiallyFilled CancelRestrictionsType = "ONLY_PARTIALLY_FILLED"
+
+	OrderRateLimitExceededModeDoNothing  OrderRateLimitExceededModeType = "DO_NOTHING"
+	OrderRateLimitExceededModeCancelOnly OrderRateLimitExceededModeType = "CANCEL_ONLY"
+
+	STPModeNone        STPModeType = "NONE"
+	STPModeExpireTaker STPModeType = "EXPIRE_TAKER"
+	STPModeExpireMaker STPModeType = "EXPIRE_MAKER"
+	STPModeExpireBoth  STPModeType = "EXPIRE_BOTH"
+	STPModeDecrement   STPModeType = "DECREMENT"
+
+	ContingencyTypeOCO ContingencyType = "OCO"
+	ContingencyTypeOTO ContingencyType = "OTO"
+
+	ListStatusTypeResponse    ListStatusType = "RESPONSE"
+	ListStatusTypeExecStarted ListStatusType = "EXEC_STARTED"
+	ListStatusTypeUpdated     ListStatusType = "UPDATED"
+	ListStatusTypeAllDone     ListStatusType = "ALL_DONE"
+
+	ListOrderStatusTypeExecuting ListOrderStatusType = "EXECUTING"
+	ListOrderStatusTypeAllDone   ListOrderStatusType = "ALL_DONE"
+	ListOrderStatusTypeReject    ListOrderStatusType = "REJECT"
+
+	AlgoUrgencyTypeLow    AlgoUrgencyType = "LOW"
+	AlgoUrgencyTypeMedium AlgoUrgencyType = "MEDIUM"
+	AlgoUrgencyTypeHigh   AlgoUrgencyType = "HIGH"
+
+	AlgoStatusTypeWorking   AlgoStatusType = "WORKING"
+	AlgoStatusTypeFinished  AlgoStatusType = "FINISHED"
+	AlgoStatusTypeCancelled AlgoStatusType = "CANCELLED"
+
+	AlgoPositionSideTypeBoth  AlgoPositionSideType = "BOTH"
+	AlgoPositionSideTypeLong  AlgoPositionSideType = "LONG"
+	AlgoPositionSideTypeShort AlgoPositionSideType = "SHORT"
 )
 
 func currentTimestamp() int64 {
@@ -330,6 +424,8 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
+
+	apiErrorHooks common.APIErrorHooks
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -438,6 +534,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if e != nil {
 			c.debug("failed to unmarshal json: %s", e)
 		}
+		c.apiErrorHooks.Call(apiErr)
 		return nil, apiErr
 	}
 	return data, nil
@@ -484,6 +581,11 @@ func (c *Client) NewKlinesService() *KlinesService {
 	return &KlinesService{c: c}
 }
 
+// NewUIKlinesService init UI klines service
+func (c *Client) NewUIKlinesService() *UIKlinesService {
+	return &UIKlinesService{c: c}
+}
+
 // NewListPriceChangeStatsService init list prices change stats service
 func (c *Client) NewListPriceChangeStatsService() *ListPriceChangeStatsService {
 	return &ListPriceChangeStatsService{c: c}
@@ -504,6 +606,11 @@ func (c *Client) NewListSymbolTickerService() *ListSymbolTickerService {
 	return &ListSymbolTickerService{c: c}
 }
 
+// NewTradingDayTickerService init trading day ticker service
+func (c *Client) NewTradingDayTickerService() *TradingDayTickerService {
+	return &TradingDayTickerService{c: c}
+}
+
 // NewCreateOrderService init creating order service
 func (c *Client) NewCreateOrderService() *CreateOrderService {
 	return &CreateOrderService{c: c}
@@ -514,6 +621,21 @@ func (c *Client) NewCreateOCOService() *CreateOCOService {
 	return &CreateOCOService{c: c}
 }
 
+// NewCreateOrderListOCOService init create order list OCO service
+func (c *Client) NewCreateOrderListOCOService() *CreateOrderListOCOService {
+	return &CreateOrderListOCOService{c: c}
+}
+
+// NewCreateOrderListOTOService init create order list OTO service
+func (c *Client) NewCreateOrderListOTOService() *CreateOrderListOTOService {
+	return &CreateOrderListOTOService{c: c}
+}
+
+// NewCreateOrderListOTOCOService init create order list OTOCO service
+func (c *Client) NewCreateOrderListOTOCOService() *CreateOrderListOTOCOService {
+	return &CreateOrderListOTOCOService{c: c}
+}
+
 // NewCancelOCOService init cancel OCO service
 func (c *Client) NewCancelOCOService() *CancelOCOService {
 	return &CancelOCOService{c: c}
@@ -529,6 +651,31 @@ func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
 }
 
+// NewCreateSOROrderService init create SOR order service
+func (c *Client) NewCreateSOROrderService() *CreateSOROrderService {
+	return &CreateSOROrderService{c: c}
+}
+
+// NewListAllocationsService init list allocations service
+func (c *Client) NewListAllocationsService() *ListAllocationsService {
+	return &ListAllocationsService{c: c}
+}
+
+// NewListPreventedMatchesService init list prevented matches service
+func (c *Client) NewListPreventedMatchesService() *ListPreventedMatchesService {
+	return &ListPreventedMatchesService{c: c}
+}
+
+// NewCancelReplaceOrderService init cancel replace order service
+func (c *Client) NewCancelReplaceOrderService() *CancelReplaceOrderService {
+	return &CancelReplaceOrderService{c: c}
+}
+
+// NewAmendOrderKeepPriorityService init amend order keep priority service
+func (c *Client) NewAmendOrderKeepPriorityService() *AmendOrderKeepPriorityService {
+	return &AmendOrderKeepPriorityService{c: c}
+}
+
 // NewCancelOpenOrdersService init cancel open orders service
 func (c *Client) NewCancelOpenOrdersService() *CancelOpenOrdersService {
 	return &CancelOpenOrdersService{c: c}
@@ -644,6 +791,12 @@ func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
 }
 
+// NewExchangeInfoRegistry init exchange info registry, the cached exchange info
+// is refreshed by lookups once it is older than ttl
+func (c *Client) NewExchangeInfoRegistry(ttl time.Duration) *ExchangeInfoRegistry {
+	return newExchangeInfoRegistry(c, ttl)
+}
+
 // NewRateLimitService init rate limit service
 func (c *Client) NewRateLimitService() *RateLimitService {
 	return &RateLimitService{c: c}
@@ -1022,3 +1175,73 @@ func (c *Client) NewSubAccountFuturesAccountService() *SubAccountFuturesAccountS
 func (c *Client) NewSubAccountFuturesSummaryV1Service() *SubAccountFuturesSummaryV1Service {
 	return &SubAccountFuturesSummaryV1Service{c: c}
 }
+
+// NewCreateFuturesAlgoVpOrderService init service creating futures volume participation algo orders
+func (c *Client) NewCreateFuturesAlgoVpOrderService() *CreateFuturesAlgoVpOrderService {
+	return &CreateFuturesAlgoVpOrderService{c: c}
+}
+
+// NewCreateFuturesAlgoTwapOrderService init service creating futures TWAP algo orders
+func (c *Client) NewCreateFuturesAlgoTwapOrderService() *CreateFuturesAlgoTwapOrderService {
+	return &CreateFuturesAlgoTwapOrderService{c: c}
+}
+
+// NewCancelFuturesAlgoOrderService init service cancelling futures algo orders
+func (c *Client) NewCancelFuturesAlgoOrderService() *CancelAlgoOrderService {
+	return &CancelAlgoOrderService{c: c, market: algoMarketFutures}
+}
+
+// NewListFuturesAlgoOpenOrdersService init service listing open futures algo orders
+func (c *Client) NewListFuturesAlgoOpenOrdersService() *ListAlgoOpenOrdersService {
+	return &ListAlgoOpenOrdersService{c: c, market: algoMarketFutures}
+}
+
+// NewListFuturesAlgoHistoricalOrdersService init service listing historical futures algo orders
+func (c *Client) NewListFuturesAlgoHistoricalOrdersService() *ListAlgoHistoricalOrdersService {
+	return &ListAlgoHistoricalOrdersService{c: c, market: algoMarketFutures}
+}
+
+// NewListFuturesAlgoSubOrdersService init service listing futures algo sub orders
+func (c *Client) NewListFuturesAlgoSubOrdersService() *ListAlgoSubOrdersService {
+	return &ListAlgoSubOrdersService{c: c, market: algoMarketFutures}
+}
+
+// NewCreateSpotAlgoTwapOrderService init service creating spot TWAP algo orders
+func (c *Client) NewCreateSpotAlgoTwapOrderService() *CreateSpotAlgoTwapOrderService {
+	return &CreateSpotAlgoTwapOrderService{c: c}
+}
+
+// NewCancelSpotAlgoOrderService init service cancelling spot algo orders
+func (c *Client) NewCancelSpotAlgoOrderService() *CancelAlgoOrderService {
+	return &CancelAlgoOrderService{c: c, market: algoMarketSpot}
+}
+
+// NewListSpotAlgoOpenOrdersService init service listing open spot algo orders
+func (c *Client) NewListSpotAlgoOpenOrdersService() *ListAlgoOpenOrdersService {
+	return &ListAlgoOpenOrdersService{c: c, market: algoMarketSpot}
+}
+
+// NewListSpotAlgoHistoricalOrdersService init service listing historical spot algo orders
+func (c *Client) NewListSpotAlgoHistoricalOrdersService() *ListAlgoHistoricalOrdersService {
+	return &ListAlgoHistoricalOrdersService{c: c, market: algoMarketSpot}
+}
+
+// NewListSpotAlgoSubOrdersService init service listing spot algo sub orders
+func (c *Client) NewListSpotAlgoSubOrdersService() *ListAlgoSubOrdersService {
+	return &ListAlgoSubOrdersService{c: c, market: algoMarketSpot}
+}
+
+// NewOrderTracker init a tracker of the spot orders of the account
+func (c *Client) NewOrderTracker() *OrderTracker {
+	return &OrderTracker{
+		c:          c,
+		byID:       make(map[int64]*trackedOrder),
+		byClientID: make(map[string]*trackedOrder),
+	}
+}
+
+// NewBalanceTracker init a tracker of the balances of the spot account,
+// or of a margin account with Margin or IsolatedMargin
+func (c *Client) NewBalanceTracker() *BalanceTracker {
+	return &BalanceTracker{c: c, balances: make(map[string]*TrackedBalance)}
+}
@@ -0,0 +1,448 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode define how a Decimal is rounded when digits are dropped
+type RoundingMode int
+
+// Rounding modes
+const (
+	// RoundDown rounds towards zero (truncation)
+	RoundDown RoundingMode = iota
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundHalfUp rounds to nearest, ties away from zero
+	RoundHalfUp
+	// RoundHalfEven rounds to nearest, ties to the even neighbour
+	RoundHalfEven
+	// RoundFloor rounds towards negative infinity
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity
+	RoundCeiling
+)
+
+// maxDecimalExponent bound the exponent accepted by ParseDecimal, a larger
+// one would build a huge coefficient
+const maxDecimalExponent = 1000
+
+var (
+	bigZero = big.NewInt(0)
+	bigOne  = big.NewInt(1)
+	bigTen  = big.NewInt(10)
+)
+
+// Decimal is an exact fixed-point decimal number, stored as an arbitrary
+// precision integer coefficient and a number of fractional digits (scale).
+// The zero value is 0. Decimal values are immutable: every operation returns
+// a new value.
+type Decimal struct {
+	coef  *big.Int
+	scale int32
+}
+
+// NewDecimal returns coef * 10^-scale, e.g. NewDecimal(123, 2) is 1.23
+func NewDecimal(coef int64, scale int32) Decimal {
+	return newDecimal(big.NewInt(coef), scale)
+}
+
+// NewDecimalFromInt returns the decimal representation of an integer
+func NewDecimalFromInt(i int64) Decimal {
+	return NewDecimal(i, 0)
+}
+
+// NewDecimalFromFloat returns the shortest decimal that round-trips to f.
+// Prefer ParseDecimal for values received from the API.
+func NewDecimalFromFloat(f float64) (Decimal, error) {
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// ParseDecimal parses a decimal string such as "0.00100000", "-12", "1.5e-3".
+// An empty string is parsed as zero, as Binance uses it for unset amounts.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, nil
+	}
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+		if e < -maxDecimalExponent || e > maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("decimal exponent out of range %q", s)
+		}
+		mantissa, exp = s[:i], e
+	}
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+	sign := ""
+	if len(intPart) > 0 && (intPart[0] == '-' || intPart[0] == '+') {
+		sign, intPart = intPart[:1], intPart[1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	digits := intPart + fracPart
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+	}
+	coef, ok := new(big.Int).SetString(sign+digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	scale := int64(len(fracPart)) - exp
+	if scale < -1<<31 || scale > 1<<31-1 {
+		return Decimal{}, fmt.Errorf("decimal exponent out of range %q", s)
+	}
+	return newDecimal(coef, int32(scale)), nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is not a valid decimal
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// newDecimal builds a decimal and folds a negative scale into the coefficient
+func newDecimal(coef *big.Int, scale int32) Decimal {
+	if scale < 0 {
+		coef = new(big.Int).Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) coefficient() *big.Int {
+	if d.coef == nil {
+		return bigZero
+	}
+	return d.coef
+}
+
+// rescale returns the coefficient of d expressed with the given (larger) scale
+func (d Decimal) rescale(scale int32) *big.Int {
+	if scale == d.scale {
+		return d.coefficient()
+	}
+	return new(big.Int).Mul(d.coefficient(), pow10(scale-d.scale))
+}
+
+func maxScale(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Scale return the number of fractional digits of d
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Sign return -1, 0 or 1 depending on the sign of d
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+// IsZero report whether d is zero
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// IsNegative report whether d is lower than zero
+func (d Decimal) IsNegative() bool {
+	return d.Sign() < 0
+}
+
+// IsPositive report whether d is greater than zero
+func (d Decimal) IsPositive() bool {
+	return d.Sign() > 0
+}
+
+// Neg return -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.coefficient()), scale: d.scale}
+}
+
+// Abs return |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.coefficient()), scale: d.scale}
+}
+
+// Add return d + d2
+func (d Decimal) Add(d2 Decimal) Decimal {
+	scale := maxScale(d.scale, d2.scale)
+	return Decimal{coef: new(big.Int).Add(d.rescale(scale), d2.rescale(scale)), scale: scale}
+}
+
+// Sub return d - d2
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	scale := maxScale(d.scale, d2.scale)
+	return Decimal{coef: new(big.Int).Sub(d.rescale(scale), d2.rescale(scale)), scale: scale}
+}
+
+// Mul return d * d2, exactly
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.coefficient(), d2.coefficient()), scale: d.scale + d2.scale}
+}
+
+// Div return d / d2 rounded to the given number of fractional digits.
+// It panics if d2 is zero.
+func (d Decimal) Div(d2 Decimal, places int32, mode RoundingMode) Decimal {
+	if d2.IsZero() {
+		panic("common: decimal division by zero")
+	}
+	// d / d2 * 10^places = (c1 * 10^(places + s2)) / (c2 * 10^s1)
+	num := new(big.Int).Set(d.coefficient())
+	den := new(big.Int).Set(d2.coefficient())
+	if e := places + d2.scale; e > 0 {
+		num.Mul(num, pow10(e))
+	} else if e < 0 {
+		den.Mul(den, pow10(-e))
+	}
+	if d.scale > 0 {
+		den.Mul(den, pow10(d.scale))
+	}
+	return newDecimal(quoRound(num, den, mode), places)
+}
+
+// Round return d rounded to the given number of fractional digits
+func (d Decimal) Round(places int32, mode RoundingMode) Decimal {
+	if places >= d.scale {
+		return Decimal{coef: d.rescale(places), scale: places}
+	}
+	return newDecimal(quoRound(d.coefficient(), pow10(d.scale-places), mode), places)
+}
+
+// Truncate return d with all digits after the given number of fractional digits dropped
+func (d Decimal) Truncate(places int32) Decimal {
+	if places >= d.scale {
+		return d
+	}
+	return d.Round(places, RoundDown)
+}
+
+// Quantize return d rounded to a multiple of step, such as a tick size or a lot
+// step size. The result has the scale of step. A zero step returns d unchanged.
+func (d Decimal) Quantize(step Decimal, mode RoundingMode) Decimal {
+	if step.IsZero() {
+		return d
+	}
+	step = step.Abs()
+	n := d.Div(step, 0, mode)
+	return Decimal{coef: new(big.Int).Mul(n.coefficient(), step.coefficient()), scale: step.scale}
+}
+
+// IsMultipleOf report whether d is an exact multiple of step
+func (d Decimal) IsMultipleOf(step Decimal) bool {
+	if step.IsZero() {
+		return true
+	}
+	scale := maxScale(d.scale, step.scale)
+	r := new(big.Int).Rem(d.rescale(scale), step.rescale(scale))
+	return r.Sign() == 0
+}
+
+// Trim return d without trailing fractional zeros, e.g. 1.2300 becomes 1.23
+func (d Decimal) Trim() Decimal {
+	coef, scale := d.coefficient(), d.scale
+	if coef.Sign() == 0 {
+		return Decimal{}
+	}
+	r := new(big.Int)
+	for scale > 0 {
+		q, m := new(big.Int).QuoRem(coef, bigTen, r)
+		if m.Sign() != 0 {
+			break
+		}
+		coef = q
+		scale--
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+// Cmp compare d and d2 and return -1 if d < d2, 0 if d == d2 and 1 if d > d2
+func (d Decimal) Cmp(d2 Decimal) int {
+	scale := maxScale(d.scale, d2.scale)
+	return d.rescale(scale).Cmp(d2.rescale(scale))
+}
+
+// Equal report whether d and d2 represent the same number, regardless of scale
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// LessThan report whether d < d2
+func (d Decimal) LessThan(d2 Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+// LessThanOrEqual report whether d <= d2
+func (d Decimal) LessThanOrEqual(d2 Decimal) bool {
+	return d.Cmp(d2) <= 0
+}
+
+// GreaterThan report whether d > d2
+func (d Decimal) GreaterThan(d2 Decimal) bool {
+	return d.Cmp(d2) > 0
+}
+
+// GreaterThanOrEqual report whether d >= d2
+func (d Decimal) GreaterThanOrEqual(d2 Decimal) bool {
+	return d.Cmp(d2) >= 0
+}
+
+// MinDecimal return the smallest of the given decimals
+func MinDecimal(first Decimal, rest ...Decimal) Decimal {
+	m := first
+	for _, d := range rest {
+		if d.LessThan(m) {
+			m = d
+		}
+	}
+	return m
+}
+
+// MaxDecimal return the largest of the given decimals
+func MaxDecimal(first Decimal, rest ...Decimal) Decimal {
+	m := first
+	for _, d := range rest {
+		if d.GreaterThan(m) {
+			m = d
+		}
+	}
+	return m
+}
+
+// Float64 return the nearest float64 of d
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String return d in plain notation with exactly Scale() fractional digits,
+// which is the format expected by the API
+func (d Decimal) String() string {
+	coef := d.coefficient()
+	digits := new(big.Int).Abs(coef).String()
+	var b strings.Builder
+	if coef.Sign() < 0 {
+		b.WriteByte('-')
+	}
+	if d.scale == 0 {
+		b.WriteString(digits)
+		return b.String()
+	}
+	if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(d.scale)
+	b.WriteString(digits[:point])
+	b.WriteByte('.')
+	b.WriteString(digits[point:])
+	return b.String()
+}
+
+// StringFixed return d rounded half up to the given number of fractional digits
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places, RoundHalfUp).String()
+}
+
+// MarshalJSON implements json.Marshaler, encoding d as a JSON string
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts both JSON strings and
+// numbers; null and "" decode to zero.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		var err error
+		s, err = strconv.Unquote(s)
+		if err != nil {
+			return fmt.Errorf("invalid decimal %s", data)
+		}
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// quoRound return num / den rounded with the given mode
+func quoRound(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	// sign of the exact quotient
+	neg := (num.Sign() < 0) != (den.Sign() < 0)
+	away := false
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		away = true
+	case RoundFloor:
+		away = neg
+	case RoundCeiling:
+		away = !neg
+	case RoundHalfUp, RoundHalfEven:
+		c := new(big.Int).Abs(r)
+		c.Lsh(c, 1)
+		switch c.Cmp(new(big.Int).Abs(den)) {
+		case 1:
+			away = true
+		case 0:
+			away = mode == RoundHalfUp || q.Bit(0) == 1
+		}
+	}
+	if away {
+		if neg {
+			q.Sub(q, bigOne)
+		} else {
+			q.Add(q, bigOne)
+		}
+	}
+	return q
+}
@@ -0,0 +1,179 @@
+package common
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		in   string
+		want string
+		err  bool
+	}{
+		{in: "0.00100000", want: "0.00100000"},
+		{in: "-12", want: "-12"},
+		{in: "+1.5", want: "1.5"},
+		{in: ".5", want: "0.5"},
+		{in: "5.", want: "5"},
+		{in: "1.5e-3", want: "0.0015"},
+		{in: "1.5E3", want: "1500"},
+		{in: "", want: "0"},
+		{in: "11232821093480213.31232419283240912834434", want: "11232821093480213.31232419283240912834434"},
+		{in: "abc", err: true},
+		{in: "1.2.3", err: true},
+		{in: "-", err: true},
+		{in: "1e", err: true},
+		{in: "1e1000", want: "1" + strings.Repeat("0", 1000)},
+		{in: "1e2000000000", err: true},
+		{in: "1e-2000000000", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDecimal(tt.in)
+			if tt.err {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tt.want, d.String())
+		})
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	assert := assert.New(t)
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.2")
+	assert.Equal("0.3", a.Add(b).String())
+	assert.True(a.Add(b).Equal(MustParseDecimal("0.30000")))
+	assert.Equal("-0.1", a.Sub(b).String())
+	assert.Equal("0.02", a.Mul(b).String())
+	assert.Equal("0.5000", a.Div(b, 4, RoundDown).String())
+	assert.Equal("0.33", NewDecimalFromInt(1).Div(NewDecimalFromInt(3), 2, RoundHalfUp).String())
+	assert.Equal("0.67", NewDecimalFromInt(2).Div(NewDecimalFromInt(3), 2, RoundHalfUp).String())
+	assert.Equal("-0.67", NewDecimalFromInt(-2).Div(NewDecimalFromInt(3), 2, RoundHalfUp).String())
+	assert.Equal("100", NewDecimalFromInt(1).Div(MustParseDecimal("0.01"), 0, RoundDown).String())
+	assert.Equal("1.23", NewDecimal(123, 2).String())
+	assert.Equal("1200", NewDecimal(12, -2).String())
+	assert.Equal("0.1", a.Neg().Abs().String())
+	assert.Panics(func() { a.Div(Decimal{}, 2, RoundDown) })
+
+	var zero Decimal
+	assert.True(zero.IsZero())
+	assert.Equal("0", zero.String())
+	assert.Equal("0.1", zero.Add(a).String())
+}
+
+func TestDecimalCompare(t *testing.T) {
+	assert := assert.New(t)
+	a := MustParseDecimal("1.10")
+	b := MustParseDecimal("1.1")
+	c := MustParseDecimal("1.2")
+	assert.Equal(0, a.Cmp(b))
+	assert.True(a.LessThan(c))
+	assert.True(a.LessThanOrEqual(b))
+	assert.True(c.GreaterThan(a))
+	assert.True(c.GreaterThanOrEqual(c))
+	assert.True(c.IsPositive())
+	assert.True(c.Neg().IsNegative())
+	assert.Equal(c, MaxDecimal(a, c, b))
+	assert.Equal(a, MinDecimal(a, c, b))
+}
+
+func TestDecimalRound(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		in     string
+		places int32
+		mode   RoundingMode
+		want   string
+	}{
+		{"1.255", 2, RoundDown, "1.25"},
+		{"1.255", 2, RoundUp, "1.26"},
+		{"1.255", 2, RoundHalfUp, "1.26"},
+		{"1.255", 2, RoundHalfEven, "1.26"},
+		{"1.245", 2, RoundHalfEven, "1.24"},
+		{"-1.255", 2, RoundDown, "-1.25"},
+		{"-1.255", 2, RoundFloor, "-1.26"},
+		{"-1.255", 2, RoundCeiling, "-1.25"},
+		{"1.251", 2, RoundCeiling, "1.26"},
+		{"1.259", 2, RoundFloor, "1.25"},
+		{"1.2", 4, RoundDown, "1.2000"},
+		{"1250", -2, RoundHalfEven, "1200"},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.want, MustParseDecimal(tt.in).Round(tt.places, tt.mode).String(), "%s %d %d", tt.in, tt.places, tt.mode)
+	}
+	assert.Equal("1.23", MustParseDecimal("1.239").Truncate(2).String())
+	assert.Equal("1.2", MustParseDecimal("1.2000").Trim().String())
+	assert.Equal("1.24", MustParseDecimal("1.235").StringFixed(2))
+}
+
+func TestDecimalQuantize(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		amount string
+		step   string
+		mode   RoundingMode
+		want   string
+	}{
+		{"1.39", "0.00100000", RoundDown, "1.39000000"},
+		{"0.00010000", "0.00100000", RoundDown, "0.00000000"},
+		{"11232821093480213.31232419283240912834434", "0.001", RoundDown, "11232821093480213.312"},
+		{"0.30000000000000004", "0.1", RoundDown, "0.3"},
+		{"12345.678", "0.5", RoundDown, "12345.5"},
+		{"12345.678", "0.5", RoundHalfUp, "12345.5"},
+		{"12345.878", "0.5", RoundHalfUp, "12346.0"},
+		{"12345.678", "5", RoundUp, "12350"},
+		{"1.5", "0", RoundDown, "1.5"},
+	}
+	for _, tt := range tests {
+		got := MustParseDecimal(tt.amount).Quantize(MustParseDecimal(tt.step), tt.mode)
+		assert.Equal(tt.want, got.String(), "%s / %s", tt.amount, tt.step)
+	}
+	assert.True(MustParseDecimal("0.003").IsMultipleOf(MustParseDecimal("0.001")))
+	assert.False(MustParseDecimal("0.0035").IsMultipleOf(MustParseDecimal("0.001")))
+}
+
+func TestDecimalJSON(t *testing.T) {
+	assert := assert.New(t)
+	type payload struct {
+		Price    Decimal  `json:"price"`
+		Quantity Decimal  `json:"qty"`
+		Empty    Decimal  `json:"empty"`
+		Null     Decimal  `json:"null"`
+		Ptr      *Decimal `json:"ptr"`
+	}
+	var p payload
+	err := json.Unmarshal([]byte(`{"price":"0.00001000","qty":12.5,"empty":"","null":null,"ptr":"3"}`), &p)
+	assert.NoError(err)
+	assert.Equal("0.00001000", p.Price.String())
+	assert.Equal("12.5", p.Quantity.String())
+	assert.True(p.Empty.IsZero())
+	assert.True(p.Null.IsZero())
+	assert.Equal("3", p.Ptr.String())
+
+	b, err := json.Marshal(p)
+	assert.NoError(err)
+	assert.Equal(`{"price":"0.00001000","qty":"12.5","empty":"0","null":"0","ptr":"3"}`, string(b))
+
+	assert.Error(json.Unmarshal([]byte(`{"price":"x"}`), &p))
+}
+
+func TestPriceLevelParseDecimal(t *testing.T) {
+	assert := assert.New(t)
+	p := PriceLevel{Price: "0.00000001", Quantity: "100.5"}
+	price, quantity, err := p.ParseDecimal()
+	assert.NoError(err)
+	assert.Equal("0.00000001", price.String())
+	assert.Equal("100.5", quantity.String())
+
+	p.Quantity = "x"
+	_, _, err = p.ParseDecimal()
+	assert.Error(err)
+}
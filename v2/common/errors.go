@@ -1,6 +1,8 @@
 package common
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -8,6 +10,9 @@ import (
 type APIError struct {
 	Code    int64  `json:"code"`
 	Message string `json:"msg"`
+	// Data hold the details some endpoints attach to an error, such as the
+	// outcome of each half of a failed cancel-replace
+	Data json.RawMessage `json:"data,omitempty"`
 }
 
 // Error return error code and message
@@ -20,3 +25,16 @@ func IsAPIError(e error) bool {
 	_, ok := e.(*APIError)
 	return ok
 }
+
+// ErrorCodeInvalidSymbol is returned by the API when a request names a symbol
+// that does not exist or is no longer listed
+const ErrorCodeInvalidSymbol int64 = -1121
+
+// ErrSymbolNotFound is returned when a symbol is missing from the cached exchange info
+var ErrSymbolNotFound = errors.New("symbol not found in exchange info")
+
+// IsInvalidSymbolError check if e is an API error with code -1121
+func IsInvalidSymbolError(e error) bool {
+	apiErr, ok := e.(*APIError)
+	return ok && apiErr.Code == ErrorCodeInvalidSymbol
+}
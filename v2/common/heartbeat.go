@@ -0,0 +1,165 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// HeartbeatMissHandler handle a failed countdown refresh. elapsed is the time
+// since the last successful refresh of the symbol, once it exceeds the
+// countdown the open orders of the symbol have been cancelled.
+type HeartbeatMissHandler func(symbol string, elapsed time.Duration, err error)
+
+// CountdownArmFunc set the countdown of symbol, in milliseconds, a countdown
+// of 0 disabling it. O is the request option type of the market client.
+type CountdownArmFunc[O any] func(ctx context.Context, symbol string, countdownTime int64, opts ...O) error
+
+// CountdownHeartbeat keep refreshing the countdown of a set of symbols while
+// it runs, so that their open orders are cancelled if the process stops
+// refreshing it, e.g. because it crashed or lost its connection.
+type CountdownHeartbeat[O any] struct {
+	arm       CountdownArmFunc[O]
+	countdown time.Duration
+	interval  time.Duration
+	symbols   []string
+	onMiss    HeartbeatMissHandler
+	opts      []O
+
+	mu          sync.Mutex
+	startedAt   time.Time
+	lastRefresh map[string]time.Time
+}
+
+// NewCountdownHeartbeat init a heartbeat setting the countdown of symbols
+// with arm, every third of the countdown by default
+func NewCountdownHeartbeat[O any](arm CountdownArmFunc[O], countdown time.Duration, symbols ...string) *CountdownHeartbeat[O] {
+	return &CountdownHeartbeat[O]{
+		arm:         arm,
+		countdown:   countdown,
+		interval:    countdown / 3,
+		symbols:     symbols,
+		lastRefresh: make(map[string]time.Time),
+	}
+}
+
+// Interval set the refresh interval, a third of the countdown by default
+func (h *CountdownHeartbeat[O]) Interval(interval time.Duration) *CountdownHeartbeat[O] {
+	h.interval = interval
+	return h
+}
+
+// Options set the request options passed to every refresh
+func (h *CountdownHeartbeat[O]) Options(opts ...O) *CountdownHeartbeat[O] {
+	h.opts = opts
+	return h
+}
+
+// OnMiss set the handler called when a refresh fails, possibly from several
+// goroutines at once
+func (h *CountdownHeartbeat[O]) OnMiss(handler HeartbeatMissHandler) *CountdownHeartbeat[O] {
+	h.onMiss = handler
+	return h
+}
+
+// LastRefresh return the time of the last successful refresh of symbol, zero if none
+func (h *CountdownHeartbeat[O]) LastRefresh(symbol string) time.Time {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.lastRefresh[symbol]
+}
+
+// Start refresh the countdown of every symbol right away and then every
+// interval, until stopC is closed. Stopping the heartbeat leaves the last
+// countdown running, call Disarm to disable it on a clean shutdown.
+// It return an error if the countdown or the interval is not positive.
+func (h *CountdownHeartbeat[O]) Start() (doneC, stopC chan struct{}, err error) {
+	if h.countdown.Milliseconds() <= 0 {
+		return nil, nil, errors.New("countdown heartbeat: countdown must be at least 1ms")
+	}
+	if h.interval <= 0 {
+		return nil, nil, errors.New("countdown heartbeat: interval must be positive")
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	h.mu.Lock()
+	h.startedAt = time.Now()
+	h.mu.Unlock()
+	go func() {
+		defer close(doneC)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-stopC:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		ticker := time.NewTicker(h.interval)
+		defer ticker.Stop()
+		for {
+			h.refresh(ctx)
+			select {
+			case <-stopC:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return doneC, stopC, nil
+}
+
+func (h *CountdownHeartbeat[O]) refresh(ctx context.Context) {
+	// the symbols are refreshed concurrently, each request being bounded by
+	// the interval so that a slow one does not delay the next refresh of the
+	// others past their countdown
+	timeout := h.countdown
+	if h.interval < timeout {
+		timeout = h.interval
+	}
+	var wg sync.WaitGroup
+	for _, symbol := range h.symbols {
+		wg.Add(1)
+		go func(symbol string) {
+			defer wg.Done()
+			h.refreshSymbol(ctx, symbol, timeout)
+		}(symbol)
+	}
+	wg.Wait()
+}
+
+func (h *CountdownHeartbeat[O]) refreshSymbol(ctx context.Context, symbol string, timeout time.Duration) {
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	err := h.arm(reqCtx, symbol, h.countdown.Milliseconds(), h.opts...)
+	cancel()
+	if ctx.Err() != nil {
+		return
+	}
+	now := time.Now()
+	h.mu.Lock()
+	if err == nil {
+		h.lastRefresh[symbol] = now
+		h.mu.Unlock()
+		return
+	}
+	last, ok := h.lastRefresh[symbol]
+	if !ok {
+		last = h.startedAt
+	}
+	h.mu.Unlock()
+	if h.onMiss != nil {
+		h.onMiss(symbol, now.Sub(last), err)
+	}
+}
+
+// Disarm disable the countdown of every symbol
+func (h *CountdownHeartbeat[O]) Disarm(ctx context.Context, opts ...O) error {
+	for _, symbol := range h.symbols {
+		if err := h.arm(ctx, symbol, 0, opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
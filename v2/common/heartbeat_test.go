@@ -0,0 +1,130 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCountdown struct {
+	mu    sync.Mutex
+	err   error
+	calls []int64
+	opts  []string
+	// slow is the symbol whose requests hang until their context is done
+	slow string
+}
+
+func (f *fakeCountdown) arm(ctx context.Context, symbol string, countdownTime int64, opts ...string) error {
+	if symbol == f.slow {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, countdownTime)
+	f.opts = append(f.opts, opts...)
+	return f.err
+}
+
+func TestCountdownHeartbeat(t *testing.T) {
+	assert := assert.New(t)
+	f := &fakeCountdown{}
+	h := NewCountdownHeartbeat(f.arm, time.Minute, "BTCUSDT").Interval(time.Millisecond)
+	assert.True(h.LastRefresh("BTCUSDT").IsZero())
+	doneC, stopC, err := h.Start()
+	assert.NoError(err)
+	deadline := time.Now().Add(time.Second)
+	for h.LastRefresh("BTCUSDT").IsZero() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	close(stopC)
+	<-doneC
+	assert.False(h.LastRefresh("BTCUSDT").IsZero())
+	f.mu.Lock()
+	assert.Equal(int64(60000), f.calls[0])
+	f.mu.Unlock()
+}
+
+func TestCountdownHeartbeatMiss(t *testing.T) {
+	assert := assert.New(t)
+	f := &fakeCountdown{err: errors.New("too many requests")}
+	type miss struct {
+		symbol  string
+		elapsed time.Duration
+		err     error
+	}
+	missC := make(chan miss, 1)
+	h := NewCountdownHeartbeat(f.arm, time.Minute, "ETHUSDT").OnMiss(
+		func(symbol string, elapsed time.Duration, err error) {
+			missC <- miss{symbol, elapsed, err}
+		})
+	doneC, stopC, err := h.Start()
+	assert.NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+	select {
+	case m := <-missC:
+		assert.Equal("ETHUSDT", m.symbol)
+		assert.True(m.elapsed < time.Minute)
+		assert.Equal(f.err, m.err)
+	case <-time.After(time.Second):
+		t.Fatal("miss was not reported")
+	}
+	assert.True(h.LastRefresh("ETHUSDT").IsZero())
+}
+
+func TestCountdownHeartbeatSlowSymbol(t *testing.T) {
+	assert := assert.New(t)
+	f := &fakeCountdown{slow: "ETHUSDT"}
+	missC := make(chan error, 1)
+	h := NewCountdownHeartbeat(f.arm, time.Minute, "ETHUSDT", "BTCUSDT").
+		Interval(10 * time.Millisecond).
+		Options("opt").
+		OnMiss(func(symbol string, elapsed time.Duration, err error) {
+			select {
+			case missC <- err:
+			default:
+			}
+		})
+	doneC, stopC, err := h.Start()
+	assert.NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+	select {
+	case err := <-missC:
+		assert.ErrorIs(err, context.DeadlineExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("slow refresh was not bounded by the interval")
+	}
+	assert.False(h.LastRefresh("BTCUSDT").IsZero())
+	assert.True(h.LastRefresh("ETHUSDT").IsZero())
+	f.mu.Lock()
+	assert.Equal("opt", f.opts[0])
+	f.mu.Unlock()
+}
+
+func TestCountdownHeartbeatInvalid(t *testing.T) {
+	f := &fakeCountdown{}
+	_, _, err := NewCountdownHeartbeat(f.arm, time.Nanosecond, "BTCUSDT").Start()
+	assert.Error(t, err)
+	_, _, err = NewCountdownHeartbeat(f.arm, time.Minute, "BTCUSDT").Interval(0).Start()
+	assert.Error(t, err)
+	assert.Empty(t, f.calls)
+}
+
+func TestCountdownHeartbeatDisarm(t *testing.T) {
+	f := &fakeCountdown{}
+	err := NewCountdownHeartbeat(f.arm, time.Minute, "BTCUSDT", "ETHUSDT").Disarm(context.Background(), "opt")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 0}, f.calls)
+	assert.Equal(t, []string{"opt", "opt"}, f.opts)
+}
@@ -6,7 +6,9 @@ import (
 	"math"
 )
 
-// AmountToLotSize converts an amount to a lot sized amount
+// AmountToLotSize converts an amount to a lot sized amount.
+// It works on float64 and may be off by one ulp on small lot sizes, use
+// Decimal.Quantize with RoundDown for exact results.
 func AmountToLotSize(lot float64, precision int, amount float64) float64 {
 	return math.Trunc(math.Floor(amount/lot)*lot*math.Pow10(precision)) / math.Pow10(precision)
 }
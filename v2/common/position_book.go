@@ -0,0 +1,433 @@
+package common
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// TrackedPosition define a position kept up to date by a PositionBook. P is
+// the position side type and M the margin type of the market.
+type TrackedPosition[P, M ~string] struct {
+	Symbol        string
+	PositionSide  P
+	Amount        Decimal
+	EntryPrice    Decimal
+	MarkPrice     Decimal
+	UnrealizedPnL Decimal
+	// RealizedPnL is the realised profit of the fills seen since the tracker started
+	RealizedPnL Decimal
+	MarginType  M
+	UpdateTime  int64
+}
+
+// PositionUpdate define the amount and entry price of a position reported
+// by an account update
+type PositionUpdate[P, M ~string] struct {
+	Symbol       string
+	PositionSide P
+	Amount       Decimal
+	EntryPrice   Decimal
+	MarginType   M
+}
+
+// PositionRecord define a position as reported by a REST snapshot or an
+// account update, with its decimals as strings
+type PositionRecord[P, M ~string] struct {
+	Symbol       string
+	PositionSide P
+	Amount       string
+	EntryPrice   string
+	MarkPrice    string
+	MarginType   M
+}
+
+func (r PositionRecord[P, M]) parse() (*TrackedPosition[P, M], error) {
+	p := &TrackedPosition[P, M]{
+		Symbol:       r.Symbol,
+		PositionSide: r.PositionSide,
+		MarginType:   r.MarginType,
+	}
+	var err error
+	if p.Amount, err = ParseDecimal(r.Amount); err != nil {
+		return nil, err
+	}
+	if p.EntryPrice, err = ParseDecimal(r.EntryPrice); err != nil {
+		return nil, err
+	}
+	if p.MarkPrice, err = ParseDecimal(r.MarkPrice); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// PositionPnLFunc return the unrealized profit of p at its mark price
+type PositionPnLFunc[P, M ~string] func(p *TrackedPosition[P, M]) Decimal
+
+type positionKey[P ~string] struct {
+	symbol       string
+	positionSide P
+}
+
+type tradeKey struct {
+	symbol  string
+	tradeID int64
+}
+
+// maxSeenTrades is the number of trades remembered to skip the duplicated fills
+const maxSeenTrades = 10000
+
+type positionEvent[P, M ~string] struct {
+	transactionTime int64
+	updates         []PositionUpdate[P, M]
+}
+
+// PositionBook keep the positions of a derivatives account from REST
+// snapshots, account updates, realised profits and mark prices. The
+// PositionTracker of each futures market is a PositionBook fed by its REST
+// client and user data stream.
+//
+// Account updates received while a snapshot is in flight are queued and
+// applied on top of it if they are newer than the request.
+type PositionBook[P, M ~string] struct {
+	pnl      PositionPnLFunc[P, M]
+	tracks   func(symbol string) bool
+	onChange func(p TrackedPosition[P, M])
+
+	mu        sync.Mutex
+	positions map[positionKey[P]]*TrackedPosition[P, M]
+	marks     map[string]Decimal
+	syncing   bool
+	pending   []positionEvent[P, M]
+	trades    map[tradeKey]struct{}
+	tradeLog  []tradeKey
+}
+
+// NewPositionBook init a book computing the unrealized profit with pnl
+func NewPositionBook[P, M ~string](pnl PositionPnLFunc[P, M]) *PositionBook[P, M] {
+	return &PositionBook[P, M]{
+		pnl:       pnl,
+		positions: make(map[positionKey[P]]*TrackedPosition[P, M]),
+		marks:     make(map[string]Decimal),
+		trades:    make(map[tradeKey]struct{}),
+	}
+}
+
+// Tracks set the filter of the symbols kept by the book, all by default
+func (b *PositionBook[P, M]) Tracks(tracks func(symbol string) bool) *PositionBook[P, M] {
+	b.tracks = tracks
+	return b
+}
+
+// OnChange set the handler called with every position that changed
+func (b *PositionBook[P, M]) OnChange(handler func(p TrackedPosition[P, M])) *PositionBook[P, M] {
+	b.onChange = handler
+	return b
+}
+
+func (b *PositionBook[P, M]) tracked(symbol string) bool {
+	return b.tracks == nil || b.tracks(symbol)
+}
+
+func (b *PositionBook[P, M]) notify(changed []TrackedPosition[P, M]) {
+	if b.onChange == nil {
+		return
+	}
+	for _, p := range changed {
+		b.onChange(p)
+	}
+}
+
+// Position return the position of symbol on positionSide
+func (b *PositionBook[P, M]) Position(symbol string, positionSide P) (TrackedPosition[P, M], bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p, ok := b.positions[positionKey[P]{symbol, positionSide}]
+	if !ok {
+		return TrackedPosition[P, M]{}, false
+	}
+	return *p, true
+}
+
+// SymbolPositions return the positions of symbol, one per position side
+func (b *PositionBook[P, M]) SymbolPositions(symbol string) []TrackedPosition[P, M] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list(func(p *TrackedPosition[P, M]) bool { return p.Symbol == symbol })
+}
+
+// Positions return the positions with a non zero amount
+func (b *PositionBook[P, M]) Positions() []TrackedPosition[P, M] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.list(func(p *TrackedPosition[P, M]) bool { return !p.Amount.IsZero() })
+}
+
+func (b *PositionBook[P, M]) list(keep func(p *TrackedPosition[P, M]) bool) []TrackedPosition[P, M] {
+	res := make([]TrackedPosition[P, M], 0)
+	for _, p := range b.positions {
+		if keep(p) {
+			res = append(res, *p)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Symbol != res[j].Symbol {
+			return res[i].Symbol < res[j].Symbol
+		}
+		return res[i].PositionSide < res[j].PositionSide
+	})
+	return res
+}
+
+func (b *PositionBook[P, M]) position(symbol string, positionSide P) *TrackedPosition[P, M] {
+	key := positionKey[P]{symbol, positionSide}
+	p, ok := b.positions[key]
+	if !ok {
+		p = &TrackedPosition[P, M]{Symbol: symbol, PositionSide: positionSide, MarkPrice: b.marks[symbol]}
+		b.positions[key] = p
+	}
+	return p
+}
+
+// Sync replace the positions with the snapshot returned by fetch, taken at
+// requestTime and already filtered by the caller. The account updates
+// received meanwhile are applied on top of it if they are newer than the
+// request, or as is if fetch failed. The realised PnL is kept.
+func (b *PositionBook[P, M]) Sync(fetch func() (snapshot []*TrackedPosition[P, M], requestTime int64, err error)) error {
+	b.mu.Lock()
+	if b.syncing {
+		b.mu.Unlock()
+		return errors.New("position tracker: already syncing")
+	}
+	b.syncing = true
+	b.pending = nil
+	b.mu.Unlock()
+
+	snapshot, requestTime, err := fetch()
+
+	b.mu.Lock()
+	pending := b.pending
+	b.syncing = false
+	b.pending = nil
+	var changed []TrackedPosition[P, M]
+	if err == nil {
+		changed = b.applySnapshot(snapshot, requestTime)
+	}
+	for _, event := range pending {
+		if err != nil || event.transactionTime >= requestTime {
+			changed = append(changed, b.applyEvent(event)...)
+		}
+	}
+	b.mu.Unlock()
+	b.notify(changed)
+	return err
+}
+
+// SyncRecords is Sync with a snapshot of records, failing if one of them
+// cannot be parsed
+func (b *PositionBook[P, M]) SyncRecords(fetch func() (snapshot []PositionRecord[P, M], requestTime int64, err error)) error {
+	return b.Sync(func() ([]*TrackedPosition[P, M], int64, error) {
+		records, requestTime, err := fetch()
+		if err != nil {
+			return nil, requestTime, err
+		}
+		snapshot := make([]*TrackedPosition[P, M], 0, len(records))
+		for _, r := range records {
+			p, err := r.parse()
+			if err != nil {
+				return nil, requestTime, err
+			}
+			snapshot = append(snapshot, p)
+		}
+		return snapshot, requestTime, nil
+	})
+}
+
+func (b *PositionBook[P, M]) applySnapshot(snapshot []*TrackedPosition[P, M], requestTime int64) []TrackedPosition[P, M] {
+	var changed []TrackedPosition[P, M]
+	positions := make(map[positionKey[P]]*TrackedPosition[P, M], len(snapshot))
+	for _, p := range snapshot {
+		key := positionKey[P]{p.Symbol, p.PositionSide}
+		if mark, ok := b.marks[p.Symbol]; ok && p.MarkPrice.IsZero() {
+			p.MarkPrice = mark
+		}
+		p.UpdateTime = requestTime
+		p.UnrealizedPnL = b.pnl(p)
+		old, ok := b.positions[key]
+		if ok {
+			p.RealizedPnL = old.RealizedPnL
+		}
+		if !ok || !old.Amount.Equal(p.Amount) || !old.EntryPrice.Equal(p.EntryPrice) || !old.MarkPrice.Equal(p.MarkPrice) {
+			changed = append(changed, *p)
+		}
+		positions[key] = p
+	}
+	for key, old := range b.positions {
+		if _, ok := positions[key]; ok {
+			continue
+		}
+		if !old.Amount.IsZero() {
+			old.Amount = Decimal{}
+			old.UnrealizedPnL = Decimal{}
+			changed = append(changed, *old)
+		}
+		positions[key] = old
+	}
+	b.positions = positions
+	return changed
+}
+
+// ApplyAccountUpdate apply the positions of an account update, queued until
+// the end of a running Sync
+func (b *PositionBook[P, M]) ApplyAccountUpdate(transactionTime int64, updates []PositionUpdate[P, M]) {
+	event := positionEvent[P, M]{transactionTime: transactionTime, updates: updates}
+	var changed []TrackedPosition[P, M]
+	b.mu.Lock()
+	if b.syncing {
+		b.pending = append(b.pending, event)
+	} else {
+		changed = b.applyEvent(event)
+	}
+	b.mu.Unlock()
+	b.notify(changed)
+}
+
+// ApplyAccountRecords is ApplyAccountUpdate with the positions of an
+// account update as records, skipping and returning the first one that
+// cannot be parsed
+func (b *PositionBook[P, M]) ApplyAccountRecords(transactionTime int64, records []PositionRecord[P, M]) (err error) {
+	var updates []PositionUpdate[P, M]
+	for _, r := range records {
+		if !b.tracked(r.Symbol) {
+			continue
+		}
+		p, perr := r.parse()
+		if perr != nil {
+			if err == nil {
+				err = perr
+			}
+			continue
+		}
+		updates = append(updates, PositionUpdate[P, M]{
+			Symbol:       p.Symbol,
+			PositionSide: p.PositionSide,
+			Amount:       p.Amount,
+			EntryPrice:   p.EntryPrice,
+			MarginType:   p.MarginType,
+		})
+	}
+	b.ApplyAccountUpdate(transactionTime, updates)
+	return err
+}
+
+func (b *PositionBook[P, M]) applyEvent(event positionEvent[P, M]) []TrackedPosition[P, M] {
+	var changed []TrackedPosition[P, M]
+	for _, u := range event.updates {
+		if !b.tracked(u.Symbol) {
+			continue
+		}
+		p := b.position(u.Symbol, u.PositionSide)
+		if event.transactionTime < p.UpdateTime {
+			continue
+		}
+		p.Amount = u.Amount
+		p.EntryPrice = u.EntryPrice
+		p.MarginType = u.MarginType
+		p.UpdateTime = event.transactionTime
+		p.UnrealizedPnL = b.pnl(p)
+		changed = append(changed, *p)
+	}
+	return changed
+}
+
+// ApplyRealizedPnL add the realised profit of the fill tradeID to its
+// position, once per trade, a tradeID of 0 being always applied. It is not
+// part of the snapshots and is applied right away.
+func (b *PositionBook[P, M]) ApplyRealizedPnL(symbol string, positionSide P, tradeID int64, pnl Decimal) {
+	if pnl.IsZero() || !b.tracked(symbol) {
+		return
+	}
+	b.mu.Lock()
+	if !b.newTrade(symbol, tradeID) {
+		b.mu.Unlock()
+		return
+	}
+	p := b.position(symbol, positionSide)
+	p.RealizedPnL = p.RealizedPnL.Add(pnl)
+	changed := *p
+	b.mu.Unlock()
+	b.notify([]TrackedPosition[P, M]{changed})
+}
+
+// ApplyTradeRecord is ApplyRealizedPnL with the profit as a string
+func (b *PositionBook[P, M]) ApplyTradeRecord(symbol string, positionSide P, tradeID int64, realizedPnL string) error {
+	if !b.tracked(symbol) {
+		return nil
+	}
+	pnl, err := ParseDecimal(realizedPnL)
+	if err != nil {
+		return err
+	}
+	b.ApplyRealizedPnL(symbol, positionSide, tradeID, pnl)
+	return nil
+}
+
+// newTrade record tradeID and return whether it was not seen before, the
+// oldest trades being forgotten past maxSeenTrades
+func (b *PositionBook[P, M]) newTrade(symbol string, tradeID int64) bool {
+	if tradeID == 0 {
+		return true
+	}
+	key := tradeKey{symbol, tradeID}
+	if _, ok := b.trades[key]; ok {
+		return false
+	}
+	b.trades[key] = struct{}{}
+	b.tradeLog = append(b.tradeLog, key)
+	if len(b.tradeLog) > maxSeenTrades {
+		delete(b.trades, b.tradeLog[0])
+		b.tradeLog = b.tradeLog[1:]
+	}
+	return true
+}
+
+// ApplyMarkPriceRecord is ApplyMarkPrice with the mark price as a string
+func (b *PositionBook[P, M]) ApplyMarkPriceRecord(symbol string, mark string) error {
+	d, err := ParseDecimal(mark)
+	if err != nil {
+		return err
+	}
+	b.ApplyMarkPrice(symbol, d)
+	return nil
+}
+
+// ApplyMarkPrice mark the positions of symbol to mark
+func (b *PositionBook[P, M]) ApplyMarkPrice(symbol string, mark Decimal) {
+	var changed []TrackedPosition[P, M]
+	b.mu.Lock()
+	b.marks[symbol] = mark
+	for _, p := range b.positions {
+		if p.Symbol != symbol || p.MarkPrice.Equal(mark) {
+			continue
+		}
+		p.MarkPrice = mark
+		p.UnrealizedPnL = b.pnl(p)
+		changed = append(changed, *p)
+	}
+	b.mu.Unlock()
+	b.notify(changed)
+}
+
+// ServeSynced start a stream with serve and sync its book once connected,
+// stopping the stream if the sync fails
+func ServeSynced(serve func() (doneC, stopC chan struct{}, err error), sync func() error) (doneC, stopC chan struct{}, err error) {
+	doneC, stopC, err = serve()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = sync(); err != nil {
+		close(stopC)
+		return nil, nil, err
+	}
+	return doneC, stopC, nil
+}
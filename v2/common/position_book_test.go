@@ -0,0 +1,141 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type bookSide string
+
+type bookMargin string
+
+func newTestPositionBook(changes *[]TrackedPosition[bookSide, bookMargin]) *PositionBook[bookSide, bookMargin] {
+	pnl := func(p *TrackedPosition[bookSide, bookMargin]) Decimal {
+		return p.Amount.Mul(p.MarkPrice.Sub(p.EntryPrice))
+	}
+	return NewPositionBook(pnl).
+		Tracks(func(symbol string) bool { return symbol != "LTCUSDT" }).
+		OnChange(func(p TrackedPosition[bookSide, bookMargin]) { *changes = append(*changes, p) })
+}
+
+func TestPositionBook(t *testing.T) {
+	assert := assert.New(t)
+	var changes []TrackedPosition[bookSide, bookMargin]
+	b := newTestPositionBook(&changes)
+
+	b.ApplyMarkPrice("BTCUSDT", MustParseDecimal("110"))
+	err := b.Sync(func() ([]*TrackedPosition[bookSide, bookMargin], int64, error) {
+		// received while the snapshot is in flight
+		b.ApplyAccountUpdate(900, []PositionUpdate[bookSide, bookMargin]{
+			{Symbol: "BTCUSDT", PositionSide: "BOTH", Amount: MustParseDecimal("7"), EntryPrice: MustParseDecimal("80")},
+		})
+		b.ApplyAccountUpdate(1100, []PositionUpdate[bookSide, bookMargin]{
+			{Symbol: "ETHUSDT", PositionSide: "BOTH", Amount: MustParseDecimal("2"), EntryPrice: MustParseDecimal("1000")},
+			{Symbol: "LTCUSDT", PositionSide: "BOTH", Amount: MustParseDecimal("1"), EntryPrice: MustParseDecimal("50")},
+		})
+		assert.Empty(changes)
+		return []*TrackedPosition[bookSide, bookMargin]{
+			{Symbol: "BTCUSDT", PositionSide: "BOTH", Amount: MustParseDecimal("1"), EntryPrice: MustParseDecimal("100")},
+		}, 1000, nil
+	})
+	assert.NoError(err)
+	assert.Len(changes, 2)
+
+	btc, ok := b.Position("BTCUSDT", "BOTH")
+	assert.True(ok)
+	assert.True(btc.Amount.Equal(MustParseDecimal("1")))
+	assert.True(btc.UnrealizedPnL.Equal(MustParseDecimal("10")))
+	assert.Equal(int64(1000), btc.UpdateTime)
+	_, ok = b.Position("LTCUSDT", "BOTH")
+	assert.False(ok)
+	assert.Len(b.Positions(), 2)
+
+	b.ApplyRealizedPnL("BTCUSDT", "BOTH", 7, MustParseDecimal("1.5"))
+	b.ApplyRealizedPnL("BTCUSDT", "BOTH", 8, Decimal{})
+	// a fill reported twice is applied once
+	b.ApplyRealizedPnL("BTCUSDT", "BOTH", 7, MustParseDecimal("1.5"))
+	assert.Len(changes, 3)
+	b.ApplyMarkPrice("BTCUSDT", MustParseDecimal("120"))
+	btc, _ = b.Position("BTCUSDT", "BOTH")
+	assert.True(btc.RealizedPnL.Equal(MustParseDecimal("1.5")))
+	assert.True(btc.UnrealizedPnL.Equal(MustParseDecimal("20")))
+
+	// an older update is ignored, a failed sync applies the queued updates as is
+	b.ApplyAccountUpdate(999, []PositionUpdate[bookSide, bookMargin]{
+		{Symbol: "BTCUSDT", PositionSide: "BOTH", Amount: MustParseDecimal("5")},
+	})
+	err = b.Sync(func() ([]*TrackedPosition[bookSide, bookMargin], int64, error) {
+		b.ApplyAccountUpdate(1200, []PositionUpdate[bookSide, bookMargin]{
+			{Symbol: "BTCUSDT", PositionSide: "BOTH", Amount: MustParseDecimal("3"), EntryPrice: MustParseDecimal("100")},
+		})
+		return nil, 1300, errors.New("fail")
+	})
+	assert.Error(err)
+	btc, _ = b.Position("BTCUSDT", "BOTH")
+	assert.True(btc.Amount.Equal(MustParseDecimal("3")))
+	assert.True(btc.RealizedPnL.Equal(MustParseDecimal("1.5")))
+}
+
+func TestPositionBookRecords(t *testing.T) {
+	assert := assert.New(t)
+	var changes []TrackedPosition[bookSide, bookMargin]
+	b := newTestPositionBook(&changes)
+
+	err := b.SyncRecords(func() ([]PositionRecord[bookSide, bookMargin], int64, error) {
+		return []PositionRecord[bookSide, bookMargin]{
+			{Symbol: "BTCUSDT", PositionSide: "BOTH", Amount: "1", EntryPrice: "100", MarkPrice: "110", MarginType: "cross"},
+		}, 1000, nil
+	})
+	assert.NoError(err)
+	btc, _ := b.Position("BTCUSDT", "BOTH")
+	assert.True(btc.UnrealizedPnL.Equal(MustParseDecimal("10")))
+	assert.Equal(bookMargin("cross"), btc.MarginType)
+
+	err = b.SyncRecords(func() ([]PositionRecord[bookSide, bookMargin], int64, error) {
+		return []PositionRecord[bookSide, bookMargin]{{Symbol: "BTCUSDT", Amount: "x"}}, 1100, nil
+	})
+	assert.Error(err)
+
+	// the invalid and untracked positions are skipped
+	err = b.ApplyAccountRecords(1200, []PositionRecord[bookSide, bookMargin]{
+		{Symbol: "ETHUSDT", PositionSide: "BOTH", Amount: "x"},
+		{Symbol: "LTCUSDT", PositionSide: "BOTH", Amount: "y"},
+		{Symbol: "BTCUSDT", PositionSide: "BOTH", Amount: "2", EntryPrice: "105"},
+	})
+	assert.Error(err)
+	btc, _ = b.Position("BTCUSDT", "BOTH")
+	assert.True(btc.Amount.Equal(MustParseDecimal("2")))
+	_, ok := b.Position("ETHUSDT", "BOTH")
+	assert.False(ok)
+
+	assert.NoError(b.ApplyTradeRecord("BTCUSDT", "BOTH", 1, "2.5"))
+	assert.NoError(b.ApplyTradeRecord("BTCUSDT", "BOTH", 1, "2.5"))
+	assert.NoError(b.ApplyTradeRecord("LTCUSDT", "BOTH", 1, "x"))
+	assert.Error(b.ApplyTradeRecord("BTCUSDT", "BOTH", 2, "x"))
+	assert.NoError(b.ApplyMarkPriceRecord("BTCUSDT", "120"))
+	assert.Error(b.ApplyMarkPriceRecord("BTCUSDT", "x"))
+	btc, _ = b.Position("BTCUSDT", "BOTH")
+	assert.True(btc.RealizedPnL.Equal(MustParseDecimal("2.5")))
+	assert.True(btc.UnrealizedPnL.Equal(MustParseDecimal("30")))
+}
+
+func TestServeSynced(t *testing.T) {
+	serve := func() (chan struct{}, chan struct{}, error) {
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	doneC, stopC, err := ServeSynced(serve, func() error { return nil })
+	assert.NoError(t, err)
+	assert.NotNil(t, doneC)
+	assert.NotNil(t, stopC)
+
+	var served chan struct{}
+	_, _, err = ServeSynced(func() (chan struct{}, chan struct{}, error) {
+		served = make(chan struct{})
+		return make(chan struct{}), served, nil
+	}, func() error { return errors.New("fail") })
+	assert.Error(t, err)
+	_, ok := <-served
+	assert.False(t, ok)
+}
@@ -23,3 +23,18 @@ func (p *PriceLevel) Parse() (float64, float64, error) {
 	}
 	return price, quantity, nil
 }
+
+// ParseDecimal parses this PriceLevel's Price and Quantity as exact
+// decimals and returns them both.  It also returns an error if either
+// fails to parse.
+func (p *PriceLevel) ParseDecimal() (Decimal, Decimal, error) {
+	price, err := ParseDecimal(p.Price)
+	if err != nil {
+		return Decimal{}, Decimal{}, err
+	}
+	quantity, err := ParseDecimal(p.Quantity)
+	if err != nil {
+		return price, Decimal{}, err
+	}
+	return price, quantity, nil
+}
@@ -0,0 +1,280 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RegistryKeys define the keys a symbol is indexed by in a Registry
+type RegistryKeys struct {
+	Symbol     string
+	BaseAsset  string
+	QuoteAsset string
+	Status     string
+}
+
+// RegistryFetcher download the exchange info I of a market and return its
+// symbols S, in exchange info order
+type RegistryFetcher[I, S, O any] func(ctx context.Context, opts ...O) (info I, symbols []S, err error)
+
+// Registry cache the exchange info I of a market and index its symbols S by
+// name, base asset, quote asset and status. O is the request option type of
+// the market client. The ExchangeInfoRegistry of each market is a Registry
+// with a fetcher adapting its exchange info.
+//
+// Symbol and ExchangeInfo refresh the cache when it is older than the TTL (a
+// zero TTL never expires it) or after ObserveError saw an invalid symbol
+// error. The other lookups only read the cache.
+type Registry[I, S, O any] struct {
+	fetch RegistryFetcher[I, S, O]
+	keys  func(S) RegistryKeys
+	ttl   time.Duration
+
+	refreshMu sync.Mutex
+	mu        sync.RWMutex
+	loaded    bool
+	info      I
+	list      []S
+	symbols   map[string]S
+	byBase    map[string][]S
+	byQuote   map[string][]S
+	byStatus  map[string][]S
+	updatedAt time.Time
+	stale     bool
+	kickC     chan struct{}
+}
+
+// NewRegistry init a registry downloading the exchange info with fetch and
+// indexing the symbols by keys
+func NewRegistry[I, S, O any](ttl time.Duration, fetch RegistryFetcher[I, S, O], keys func(S) RegistryKeys) *Registry[I, S, O] {
+	return &Registry[I, S, O]{fetch: fetch, keys: keys, ttl: ttl, kickC: make(chan struct{}, 1)}
+}
+
+// Refresh download the exchange info and rebuild the indexes
+func (r *Registry[I, S, O]) Refresh(ctx context.Context, opts ...O) error {
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+	return r.refresh(ctx, opts...)
+}
+
+func (r *Registry[I, S, O]) refresh(ctx context.Context, opts ...O) error {
+	info, list, err := r.fetch(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	symbols := make(map[string]S, len(list))
+	byBase := make(map[string][]S)
+	byQuote := make(map[string][]S)
+	byStatus := make(map[string][]S)
+	for _, s := range list {
+		k := r.keys(s)
+		symbols[k.Symbol] = s
+		byBase[k.BaseAsset] = append(byBase[k.BaseAsset], s)
+		byQuote[k.QuoteAsset] = append(byQuote[k.QuoteAsset], s)
+		byStatus[k.Status] = append(byStatus[k.Status], s)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loaded = true
+	r.info = info
+	r.list = list
+	r.symbols = symbols
+	r.byBase = byBase
+	r.byQuote = byQuote
+	r.byStatus = byStatus
+	r.updatedAt = time.Now()
+	r.stale = false
+	return nil
+}
+
+func (r *Registry[I, S, O]) expired() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return !r.loaded || r.stale || (r.ttl > 0 && time.Since(r.updatedAt) >= r.ttl)
+}
+
+func (r *Registry[I, S, O]) ensureFresh(ctx context.Context) error {
+	if !r.expired() {
+		return nil
+	}
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+	// another caller may have refreshed while we were waiting
+	if !r.expired() {
+		return nil
+	}
+	return r.refresh(ctx)
+}
+
+// ExchangeInfo return the cached exchange info, refreshing it if needed
+func (r *Registry[I, S, O]) ExchangeInfo(ctx context.Context) (I, error) {
+	if err := r.ensureFresh(ctx); err != nil {
+		var zero I
+		return zero, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.info, nil
+}
+
+// Symbol return the symbol named name, refreshing the cache if needed.
+// The error wraps ErrSymbolNotFound if the symbol is not listed.
+func (r *Registry[I, S, O]) Symbol(ctx context.Context, name string) (S, error) {
+	if err := r.ensureFresh(ctx); err != nil {
+		var zero S
+		return zero, err
+	}
+	if s, ok := r.Lookup(name); ok {
+		return s, nil
+	}
+	var zero S
+	return zero, fmt.Errorf("%w: %s", ErrSymbolNotFound, name)
+}
+
+// Lookup return the cached symbol named name without refreshing the cache
+func (r *Registry[I, S, O]) Lookup(name string) (S, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.symbols[name]
+	return s, ok
+}
+
+// Symbols return all cached symbols, in exchange info order
+func (r *Registry[I, S, O]) Symbols() []S {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]S(nil), r.list...)
+}
+
+// SymbolsByBaseAsset return the cached symbols with base asset asset
+func (r *Registry[I, S, O]) SymbolsByBaseAsset(asset string) []S {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]S(nil), r.byBase[asset]...)
+}
+
+// SymbolsByQuoteAsset return the cached symbols with quote asset asset
+func (r *Registry[I, S, O]) SymbolsByQuoteAsset(asset string) []S {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]S(nil), r.byQuote[asset]...)
+}
+
+// SymbolsByStatus return the cached symbols with status status
+func (r *Registry[I, S, O]) SymbolsByStatus(status string) []S {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]S(nil), r.byStatus[status]...)
+}
+
+// UpdateTime return the time of the last successful refresh
+func (r *Registry[I, S, O]) UpdateTime() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.updatedAt
+}
+
+// Invalidate mark the cache as stale, the next Symbol or ExchangeInfo call
+// refreshes it and a running auto refresh is woken up
+func (r *Registry[I, S, O]) Invalidate() {
+	r.mu.Lock()
+	r.stale = true
+	r.mu.Unlock()
+	select {
+	case r.kickC <- struct{}{}:
+	default:
+	}
+}
+
+// ObserveError invalidate the cache if err is an invalid symbol error (-1121),
+// which usually means a symbol was listed or delisted since the last refresh.
+// It return true if the cache was invalidated.
+func (r *Registry[I, S, O]) ObserveError(err error) bool {
+	if !IsInvalidSymbolError(err) {
+		return false
+	}
+	r.Invalidate()
+	return true
+}
+
+// StartAutoRefresh refresh the cache every interval, and right away after
+// Invalidate, until stopC is closed. Refresh errors are passed to errHandler.
+// It return an error if interval is not positive.
+func (r *Registry[I, S, O]) StartAutoRefresh(interval time.Duration, errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	if interval <= 0 {
+		return nil, nil, errors.New("registry: auto refresh interval must be positive")
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopC:
+				return
+			case <-ticker.C:
+			case <-r.kickC:
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				select {
+				case <-stopC:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			err := r.Refresh(ctx)
+			cancel()
+			if err != nil && errHandler != nil {
+				errHandler(err)
+			}
+		}
+	}()
+	return doneC, stopC, nil
+}
+
+// APIErrorHooks call hooks with the API errors a client receives. The zero
+// value has no hooks and is ready to use.
+type APIErrorHooks struct {
+	mu    sync.RWMutex
+	hooks []*apiErrorHook
+}
+
+type apiErrorHook struct {
+	call func(err *APIError)
+}
+
+// Add add a hook called with every API error until remove is called
+func (h *APIErrorHooks) Add(hook func(err *APIError)) (remove func()) {
+	entry := &apiErrorHook{call: hook}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.hooks = append(h.hooks, entry)
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		for i, e := range h.hooks {
+			if e == entry {
+				// copy so that a running Call keeps its hooks
+				h.hooks = append(h.hooks[:i:i], h.hooks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Call call the hooks with err
+func (h *APIErrorHooks) Call(err *APIError) {
+	h.mu.RLock()
+	hooks := h.hooks
+	h.mu.RUnlock()
+	for _, hook := range hooks {
+		hook.call(err)
+	}
+}
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type registrySymbol struct {
+	name, base, quote, status string
+}
+
+func newTestRegistry(calls *int, opts *[]string) *Registry[string, *registrySymbol, string] {
+	fetch := func(ctx context.Context, o ...string) (string, []*registrySymbol, error) {
+		*calls++
+		*opts = o
+		return "info", []*registrySymbol{
+			{"ETHBTC", "ETH", "BTC", "TRADING"},
+			{"ETHUSDT", "ETH", "USDT", "BREAK"},
+		}, nil
+	}
+	keys := func(s *registrySymbol) RegistryKeys {
+		return RegistryKeys{Symbol: s.name, BaseAsset: s.base, QuoteAsset: s.quote, Status: s.status}
+	}
+	return NewRegistry(0, fetch, keys)
+}
+
+func TestRegistry(t *testing.T) {
+	assert := assert.New(t)
+	var calls int
+	var opts []string
+	r := newTestRegistry(&calls, &opts)
+
+	assert.Empty(r.Symbols())
+	s, err := r.Symbol(context.Background(), "ETHBTC")
+	assert.NoError(err)
+	assert.Equal("ETH", s.base)
+	_, err = r.Symbol(context.Background(), "LTCBTC")
+	assert.True(errors.Is(err, ErrSymbolNotFound))
+	assert.Equal(1, calls)
+
+	assert.Len(r.Symbols(), 2)
+	assert.Len(r.SymbolsByBaseAsset("ETH"), 2)
+	assert.Len(r.SymbolsByQuoteAsset("USDT"), 1)
+	assert.Len(r.SymbolsByStatus("BREAK"), 1)
+
+	assert.NoError(r.Refresh(context.Background(), "opt"))
+	assert.Equal([]string{"opt"}, opts)
+
+	assert.False(r.ObserveError(&APIError{Code: -2010}))
+	assert.True(r.ObserveError(&APIError{Code: ErrorCodeInvalidSymbol}))
+	info, err := r.ExchangeInfo(context.Background())
+	assert.NoError(err)
+	assert.Equal("info", info)
+	assert.Equal(3, calls)
+}
+
+func TestRegistryAutoRefreshInterval(t *testing.T) {
+	var calls int
+	var opts []string
+	r := newTestRegistry(&calls, &opts)
+	_, _, err := r.StartAutoRefresh(0, nil)
+	assert.Error(t, err)
+	_, _, err = r.StartAutoRefresh(-1, nil)
+	assert.Error(t, err)
+}
+
+func TestAPIErrorHooks(t *testing.T) {
+	var hooks APIErrorHooks
+	var got []int64
+	hooks.Call(&APIError{Code: -1})
+	remove := hooks.Add(func(err *APIError) { got = append(got, err.Code) })
+	hooks.Add(func(err *APIError) { got = append(got, -err.Code) })
+	hooks.Call(&APIError{Code: -1121})
+	assert.Equal(t, []int64{-1121, 1121}, got)
+
+	remove()
+	remove()
+	got = nil
+	hooks.Call(&APIError{Code: -1121})
+	assert.Equal(t, []int64{1121}, got)
+}
@@ -57,6 +57,10 @@ type WorkingType string
 // MarginType define margin type
 type MarginType string
 
+// ContractType define contract type, an alias of string so that the
+// contract type fields and setters keep accepting plain strings
+type ContractType = string
+
 // UserDataEventType define user data event type
 type UserDataEventType string
 
@@ -130,6 +134,13 @@ const (
 	SymbolFilterTypeMaxNumOrders     SymbolFilterType = "MAX_NUM_ORDERS"
 	SymbolFilterTypeMaxNumAlgoOrders SymbolFilterType = "MAX_NUM_ALGO_ORDERS"
 
+	ContractTypePerpetual                ContractType = "PERPETUAL"
+	ContractTypeCurrentQuarter           ContractType = "CURRENT_QUARTER"
+	ContractTypeNextQuarter              ContractType = "NEXT_QUARTER"
+	ContractTypePerpetualDelivering      ContractType = "PERPETUAL_DELIVERING"
+	ContractTypeCurrentQuarterDelivering ContractType = "CURRENT_QUARTER_DELIVERING"
+	ContractTypeNextQuarterDelivering    ContractType = "NEXT_QUARTER_DELIVERING"
+
 	SideEffectTypeNoSideEffect SideEffectType = "NO_SIDE_EFFECT"
 	SideEffectTypeMarginBuy    SideEffectType = "MARGIN_BUY"
 	SideEffectTypeAutoRepay    SideEffectType = "AUTO_REPAY"
@@ -210,6 +221,8 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
+
+	apiErrorHooks common.APIErrorHooks
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -318,6 +331,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if e != nil {
 			c.debug("failed to unmarshal json: %s", e)
 		}
+		c.apiErrorHooks.Call(apiErr)
 		return nil, apiErr
 	}
 	return data, nil
@@ -349,6 +363,81 @@ func (c *Client) NewKlinesService() *KlinesService {
 	return &KlinesService{c: c}
 }
 
+// NewAggTradesService init aggregate trades service
+func (c *Client) NewAggTradesService() *AggTradesService {
+	return &AggTradesService{c: c}
+}
+
+// NewRecentTradesService init recent trades service
+func (c *Client) NewRecentTradesService() *RecentTradesService {
+	return &RecentTradesService{c: c}
+}
+
+// NewHistoricalTradesService init listing trades service
+func (c *Client) NewHistoricalTradesService() *HistoricalTradesService {
+	return &HistoricalTradesService{c: c}
+}
+
+// NewContinuousKlinesService init continuous klines service
+func (c *Client) NewContinuousKlinesService() *ContinuousKlinesService {
+	return &ContinuousKlinesService{c: c}
+}
+
+// NewIndexPriceKlinesService init index price klines service
+func (c *Client) NewIndexPriceKlinesService() *IndexPriceKlinesService {
+	return &IndexPriceKlinesService{c: c}
+}
+
+// NewMarkPriceKlinesService init mark price klines service
+func (c *Client) NewMarkPriceKlinesService() *MarkPriceKlinesService {
+	return &MarkPriceKlinesService{c: c}
+}
+
+// NewPremiumIndexService init premium index service
+func (c *Client) NewPremiumIndexService() *PremiumIndexService {
+	return &PremiumIndexService{c: c}
+}
+
+// NewFundingRateService init funding rate service
+func (c *Client) NewFundingRateService() *FundingRateService {
+	return &FundingRateService{c: c}
+}
+
+// NewGetOpenInterestService init open interest service
+func (c *Client) NewGetOpenInterestService() *GetOpenInterestService {
+	return &GetOpenInterestService{c: c}
+}
+
+// NewOpenInterestStatisticsService init open interest statistics service
+func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsService {
+	return &OpenInterestStatisticsService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
+
+// NewTakerBuySellVolumeService init taker buy sell volume service
+func (c *Client) NewTakerBuySellVolumeService() *TakerBuySellVolumeService {
+	return &TakerBuySellVolumeService{c: c}
+}
+
+// NewLongShortRatioService init long short ratio service
+func (c *Client) NewLongShortRatioService() *LongShortRatioService {
+	return &LongShortRatioService{c: c}
+}
+
+// NewTopLongShortAccountRatioService init top trader long short account ratio service
+func (c *Client) NewTopLongShortAccountRatioService() *TopLongShortAccountRatioService {
+	return &TopLongShortAccountRatioService{c: c}
+}
+
+// NewTopLongShortPositionRatioService init top trader long short position ratio service
+func (c *Client) NewTopLongShortPositionRatioService() *TopLongShortPositionRatioService {
+	return &TopLongShortPositionRatioService{c: c}
+}
+
 // NewListPriceChangeStatsService init list prices change stats service
 func (c *Client) NewListPriceChangeStatsService() *ListPriceChangeStatsService {
 	return &ListPriceChangeStatsService{c: c}
@@ -384,11 +473,46 @@ func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
 }
 
+// NewCountdownCancelAllService init countdown cancel all service
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
+// NewCountdownHeartbeat init a heartbeat refreshing the countdown of symbols
+func (c *Client) NewCountdownHeartbeat(countdown time.Duration, symbols ...string) *CountdownHeartbeat {
+	arm := func(ctx context.Context, symbol string, countdownTime int64, opts ...RequestOption) error {
+		_, err := c.NewCountdownCancelAllService().Symbol(symbol).CountdownTime(countdownTime).Do(ctx, opts...)
+		return err
+	}
+	return common.NewCountdownHeartbeat(arm, countdown, symbols...)
+}
+
+// NewExchangeInfoRegistry init exchange info registry, the cached exchange info
+// is refreshed by lookups once it is older than ttl
+func (c *Client) NewExchangeInfoRegistry(ttl time.Duration) *ExchangeInfoRegistry {
+	return newExchangeInfoRegistry(c, ttl)
+}
+
 // NewCreateOrderService init creating order service
 func (c *Client) NewCreateOrderService() *CreateOrderService {
 	return &CreateOrderService{c: c}
 }
 
+// NewCreateBatchOrdersService init creating batch order service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewModifyOrderService init modify order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewModifyBatchOrdersService init modify batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
 // NewGetOrderService init get order service
 func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
@@ -404,6 +528,11 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewCancelMultipleOrdersService init cancel multiple orders service
+func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
+	return &CancelMultiplesOrdersService{c: c}
+}
+
 // NewListOpenOrdersService init list open orders service
 func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
 	return &ListOpenOrdersService{c: c}
@@ -434,6 +563,31 @@ func (c *Client) NewGetPositionRiskService() *GetPositionRiskService {
 	return &GetPositionRiskService{c: c}
 }
 
+// NewListAccountTradeService init account trade list service
+func (c *Client) NewListAccountTradeService() *ListAccountTradeService {
+	return &ListAccountTradeService{c: c}
+}
+
+// NewGetIncomeHistoryService init income history service
+func (c *Client) NewGetIncomeHistoryService() *GetIncomeHistoryService {
+	return &GetIncomeHistoryService{c: c}
+}
+
+// NewGetPositionMarginHistoryService init position margin history service
+func (c *Client) NewGetPositionMarginHistoryService() *GetPositionMarginHistoryService {
+	return &GetPositionMarginHistoryService{c: c}
+}
+
+// NewGetLeverageBracketService init leverage bracket service
+func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
+	return &GetLeverageBracketService{c: c}
+}
+
+// NewCommissionRateService init commission rate service
+func (c *Client) NewCommissionRateService() *CommissionRateService {
+	return &CommissionRateService{c: c}
+}
+
 // NewChangeLeverageService init change leverage service
 func (c *Client) NewChangeLeverageService() *ChangeLeverageService {
 	return &ChangeLeverageService{c: c}
@@ -458,3 +612,13 @@ func (c *Client) NewChangePositionModeService() *ChangePositionModeService {
 func (c *Client) NewGetPositionModeService() *GetPositionModeService {
 	return &GetPositionModeService{c: c}
 }
+
+// NewPositionTracker init a tracker of the positions of the account
+func (c *Client) NewPositionTracker() *PositionTracker {
+	t := &PositionTracker{
+		c:     c,
+		sizes: make(map[string]common.Decimal),
+	}
+	t.PositionBook = common.NewPositionBook(t.unrealizedPnL).Tracks(t.tracks)
+	return t
+}
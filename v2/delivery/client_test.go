@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -42,6 +43,26 @@ func (s *baseTestSuite) assertDo() {
 	s.client.AssertCalled(s.T(), "do", anyHTTPRequest())
 }
 
+// mockDoOnce is mockDo for a single request, queue one per request when a
+// test sends several
+func (s *baseTestSuite) mockDoOnce(data []byte, err error, statusCode ...int) {
+	s.client.Client.do = s.client.do
+	code := http.StatusOK
+	if len(statusCode) > 0 {
+		code = statusCode[0]
+	}
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, code), err).Once()
+}
+
+// calledRequest return the request sent by the i-th call
+func (s *baseTestSuite) calledRequest(i int) *http.Request {
+	return s.client.Calls[i].Arguments.Get(0).(*http.Request)
+}
+
+func (s *baseTestSuite) assertDecimal(e string, a common.Decimal) {
+	s.r().True(common.MustParseDecimal(e).Equal(a), "expected %s, got %s", e, a)
+}
+
 func (s *baseTestSuite) assertReq(f func(r *request)) {
 	s.client.assertReq = f
 }
@@ -0,0 +1,67 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type continuousKlineServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestContinuousKlineService(t *testing.T) {
+	suite.Run(t, new(continuousKlineServiceTestSuite))
+}
+
+func (s *continuousKlineServiceTestSuite) TestKlines() {
+	data := []byte(`[
+		[
+			1591258320000,
+			"9640.7",
+			"9642.4",
+			"9640.6",
+			"9642.0",
+			"206",
+			1591258379999,
+			"2.13660389",
+			48,
+			"119",
+			"1.23424865",
+			"0"
+		]
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         "BTCUSD",
+			"contractType": ContractTypePerpetual,
+			"interval":     "1m",
+			"limit":        1,
+			"startTime":    1591258320000,
+			"endTime":      1591258379999,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewContinuousKlinesService().Pair("BTCUSD").ContractType(ContractTypePerpetual).
+		Interval("1m").Limit(1).StartTime(1591258320000).EndTime(1591258379999).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Kline{
+		{
+			OpenTime:                 1591258320000,
+			Open:                     "9640.7",
+			High:                     "9642.4",
+			Low:                      "9640.6",
+			Close:                    "9642.0",
+			Volume:                   "206",
+			CloseTime:                1591258379999,
+			QuoteAssetVolume:         "2.13660389",
+			TradeNum:                 48,
+			TakerBuyBaseAssetVolume:  "119",
+			TakerBuyQuoteAssetVolume: "1.23424865",
+		},
+	}, res)
+}
@@ -0,0 +1,116 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ExchangeInfoRegistry cache the COIN-M delivery exchange info and its symbols with their filters
+// parsed, see common.Registry. The invalid symbol errors (-1121) received by
+// the client that created it invalidate the cache until Close is called.
+type ExchangeInfoRegistry struct {
+	*common.Registry[*ExchangeInfo, *SymbolInfo, RequestOption]
+	removeHook func()
+}
+
+// SymbolInfo define a symbol with its filters parsed into typed values.
+// A filter is nil when the symbol does not define it.
+type SymbolInfo struct {
+	Symbol
+	Price            *PriceFilter
+	LotSize          *LotSizeFilter
+	MarketLotSize    *MarketLotSizeFilter
+	PercentPrice     *PercentPriceFilter
+	MaxNumOrders     *MaxNumOrdersFilter
+	MaxNumAlgoOrders *MaxNumAlgoOrdersFilter
+	TickSize         common.Decimal
+	StepSize         common.Decimal
+	MarketStepSize   common.Decimal
+}
+
+func newSymbolInfo(s Symbol) (*SymbolInfo, error) {
+	info := &SymbolInfo{
+		Symbol:           s,
+		Price:            s.PriceFilter(),
+		LotSize:          s.LotSizeFilter(),
+		MarketLotSize:    s.MarketLotSizeFilter(),
+		PercentPrice:     s.PercentPriceFilter(),
+		MaxNumOrders:     s.MaxNumOrdersFilter(),
+		MaxNumAlgoOrders: s.MaxNumAlgoOrdersFilter(),
+	}
+	var err error
+	if info.Price != nil {
+		if info.TickSize, err = common.ParseDecimal(info.Price.TickSize); err != nil {
+			return nil, fmt.Errorf("%s tickSize: %w", s.Symbol, err)
+		}
+	}
+	if info.LotSize != nil {
+		if info.StepSize, err = common.ParseDecimal(info.LotSize.StepSize); err != nil {
+			return nil, fmt.Errorf("%s stepSize: %w", s.Symbol, err)
+		}
+	}
+	if info.MarketLotSize != nil {
+		if info.MarketStepSize, err = common.ParseDecimal(info.MarketLotSize.StepSize); err != nil {
+			return nil, fmt.Errorf("%s market stepSize: %w", s.Symbol, err)
+		}
+	}
+	return info, nil
+}
+
+// QuantizePrice round price to a multiple of the tick size
+func (s *SymbolInfo) QuantizePrice(price common.Decimal, mode common.RoundingMode) common.Decimal {
+	return price.Quantize(s.TickSize, mode)
+}
+
+// QuantizeQuantity round quantity to a multiple of the LOT_SIZE step size
+func (s *SymbolInfo) QuantizeQuantity(quantity common.Decimal, mode common.RoundingMode) common.Decimal {
+	return quantity.Quantize(s.StepSize, mode)
+}
+
+// QuantizeMarketQuantity round quantity to a multiple of the MARKET_LOT_SIZE
+// step size, falling back to the LOT_SIZE one when it is not set
+func (s *SymbolInfo) QuantizeMarketQuantity(quantity common.Decimal, mode common.RoundingMode) common.Decimal {
+	if s.MarketStepSize.IsZero() {
+		return s.QuantizeQuantity(quantity, mode)
+	}
+	return quantity.Quantize(s.MarketStepSize, mode)
+}
+
+func newExchangeInfoRegistry(c *Client, ttl time.Duration) *ExchangeInfoRegistry {
+	fetch := func(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, []*SymbolInfo, error) {
+		info, err := c.NewExchangeInfoService().Do(ctx, opts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		symbols := make([]*SymbolInfo, 0, len(info.Symbols))
+		for _, s := range info.Symbols {
+			si, err := newSymbolInfo(s)
+			if err != nil {
+				return nil, nil, err
+			}
+			symbols = append(symbols, si)
+		}
+		return info, symbols, nil
+	}
+	keys := func(s *SymbolInfo) common.RegistryKeys {
+		return common.RegistryKeys{Symbol: s.Symbol.Symbol, BaseAsset: s.BaseAsset, QuoteAsset: s.QuoteAsset, Status: s.ContractStatus}
+	}
+	r := &ExchangeInfoRegistry{Registry: common.NewRegistry(ttl, fetch, keys)}
+	r.removeHook = c.apiErrorHooks.Add(func(err *common.APIError) { r.ObserveError(err) })
+	return r
+}
+
+// Close stop observing the errors of the client, so that the registry can be
+// garbage collected. The cache is still usable but no longer invalidated by
+// them.
+func (r *ExchangeInfoRegistry) Close() {
+	r.removeHook()
+}
+
+// SymbolsByStatus return the cached symbols with contract status status
+func (r *ExchangeInfoRegistry) SymbolsByStatus(status SymbolStatusType) []*SymbolInfo {
+	return r.Registry.SymbolsByStatus(string(status))
+}
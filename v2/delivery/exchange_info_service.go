@@ -57,7 +57,7 @@ type Symbol struct {
 	Filters               []map[string]interface{} `json:"filters"`
 	Symbol                string                   `json:"symbol"`
 	Pair                  string                   `json:"pair"`
-	ContractType          string                   `json:"contractType"`
+	ContractType          ContractType             `json:"contractType"`
 	DeliveryDate          int64                    `json:"deliveryDate"`
 	OnboardDate           int64                    `json:"onboardDate"`
 	ContractStatus        string                   `json:"contractStatus"`
@@ -75,6 +75,8 @@ type Symbol struct {
 	TriggerProtect        string                   `json:"triggerProtect"`
 	UnderlyingType        string                   `json:"underlyingType"`
 	UnderlyingSubType     []interface{}            `json:"underlyingSubType"`
+	LiquidationFee        string                   `json:"liquidationFee"`
+	MarketTakeBound       string                   `json:"marketTakeBound"`
 }
 
 // LotSizeFilter define lot size filter of symbol
@@ -198,6 +198,76 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 	s.assertPercentPriceFilterEqual(ePercentPriceFilter, res.Symbols[0].PercentPriceFilter())
 }
 
+func (s *exchangeInfoServiceTestSuite) TestExchangeInfoPerpetual() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1695889187654,
+		"rateLimits": [
+			{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 2400}
+		],
+		"exchangeFilters": [],
+		"symbols": [
+			{
+				"symbol": "BTCUSD_PERP",
+				"pair": "BTCUSD",
+				"contractType": "PERPETUAL",
+				"deliveryDate": 4133404800000,
+				"onboardDate": 1597042800000,
+				"contractStatus": "TRADING",
+				"contractSize": 100,
+				"marginAsset": "BTC",
+				"maintMarginPercent": "2.5000",
+				"requiredMarginPercent": "5.0000",
+				"baseAsset": "BTC",
+				"quoteAsset": "USD",
+				"pricePrecision": 1,
+				"quantityPrecision": 0,
+				"baseAssetPrecision": 8,
+				"quotePrecision": 8,
+				"equalQtyPrecision": 4,
+				"maxMoveOrderLimit": 10000,
+				"triggerProtect": "0.0500",
+				"underlyingType": "COIN",
+				"underlyingSubType": [],
+				"filters": [
+					{"minPrice": "1000", "maxPrice": "4520958", "filterType": "PRICE_FILTER", "tickSize": "0.1"},
+					{"stepSize": "1", "filterType": "LOT_SIZE", "maxQty": "1000000", "minQty": "1"},
+					{"stepSize": "1", "filterType": "MARKET_LOT_SIZE", "maxQty": "60000", "minQty": "1"},
+					{"limit": 200, "filterType": "MAX_NUM_ORDERS"},
+					{"limit": 20, "filterType": "MAX_NUM_ALGO_ORDERS"},
+					{"multiplierDown": "0.9500", "multiplierUp": "1.0500", "multiplierDecimal": "4", "filterType": "PERCENT_PRICE"}
+				],
+				"OrderType": ["LIMIT", "MARKET", "STOP", "STOP_MARKET", "TAKE_PROFIT", "TAKE_PROFIT_MARKET", "TRAILING_STOP_MARKET"],
+				"timeInForce": ["GTC", "IOC", "FOK", "GTX"],
+				"liquidationFee": "0.015000",
+				"marketTakeBound": "0.05"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	res, err := s.client.NewExchangeInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Symbols, 1)
+
+	symbol := res.Symbols[0]
+	r.Equal(ContractTypePerpetual, symbol.ContractType)
+	r.Equal(string(SymbolStatusTypeTrading), symbol.ContractStatus)
+	r.Equal(100, symbol.ContractSize)
+	r.Equal("0.015000", symbol.LiquidationFee)
+	r.Equal("0.05", symbol.MarketTakeBound)
+	r.Len(symbol.OrderType, 7)
+	r.Equal(OrderTypeTrailingStopMarket, symbol.OrderType[6])
+
+	s.assertPriceFilterEqual(&PriceFilter{MinPrice: "1000", MaxPrice: "4520958", TickSize: "0.1"}, symbol.PriceFilter())
+	s.assertLotSizeFilterEqual(&LotSizeFilter{MinQuantity: "1", MaxQuantity: "1000000", StepSize: "1"}, symbol.LotSizeFilter())
+	s.assertMarketLotSizeFilterEqual(&MarketLotSizeFilter{MinQuantity: "1", MaxQuantity: "60000", StepSize: "1"}, symbol.MarketLotSizeFilter())
+	s.assertMaxNumOrdersFilterEqual(&MaxNumOrdersFilter{Limit: 200}, symbol.MaxNumOrdersFilter())
+	s.assertMaxNumAlgoOrdersFilterEqual(&MaxNumAlgoOrdersFilter{Limit: 20}, symbol.MaxNumAlgoOrdersFilter())
+	s.assertPercentPriceFilterEqual(&PercentPriceFilter{MultiplierDecimal: "4", MultiplierUp: "1.0500", MultiplierDown: "0.9500"}, symbol.PercentPriceFilter())
+}
+
 func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInfo) {
 	r := s.r()
 
@@ -0,0 +1,58 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type incomeHistoryServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIncomeHistoryService(t *testing.T) {
+	suite.Run(t, new(incomeHistoryServiceTestSuite))
+}
+
+func (s *incomeHistoryServiceTestSuite) TestGetIncomeHistory() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200925",
+			"incomeType": "COMMISSION",
+			"income": "-0.01000000",
+			"asset": "BTC",
+			"info": "",
+			"time": 1570608000000,
+			"tranId": 9689322392,
+			"tradeId": "2059192"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":     "BTCUSD_200925",
+			"incomeType": "COMMISSION",
+			"startTime":  1570608000000,
+			"endTime":    1570608000001,
+			"limit":      10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetIncomeHistoryService().Symbol("BTCUSD_200925").IncomeType("COMMISSION").
+		StartTime(1570608000000).EndTime(1570608000001).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*IncomeHistory{
+		{
+			Symbol:     "BTCUSD_200925",
+			IncomeType: "COMMISSION",
+			Income:     "-0.01000000",
+			Asset:      "BTC",
+			Time:       1570608000000,
+			TranID:     9689322392,
+			TradeID:    "2059192",
+		},
+	}, res)
+}
@@ -0,0 +1,61 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type indexPriceKlineServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIndexPriceKlineService(t *testing.T) {
+	suite.Run(t, new(indexPriceKlineServiceTestSuite))
+}
+
+func (s *indexPriceKlineServiceTestSuite) TestKlines() {
+	data := []byte(`[
+		[
+			1591256400000,
+			"9653.69440000",
+			"9653.69640000",
+			"9651.38600000",
+			"9651.55200000",
+			"0",
+			1591256459999,
+			"0",
+			60,
+			"0",
+			"0",
+			"0"
+		]
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":      "BTCUSD",
+			"interval":  "1m",
+			"limit":     1,
+			"startTime": 1591256400000,
+			"endTime":   1591256459999,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewIndexPriceKlinesService().Pair("BTCUSD").Interval("1m").Limit(1).
+		StartTime(1591256400000).EndTime(1591256459999).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Kline{
+		{
+			OpenTime:  1591256400000,
+			Open:      "9653.69440000",
+			High:      "9653.69640000",
+			Low:       "9651.38600000",
+			Close:     "9651.55200000",
+			CloseTime: 1591256459999,
+		},
+	}, res)
+}
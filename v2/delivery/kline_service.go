@@ -67,6 +67,11 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	if err != nil {
 		return []*Kline{}, err
 	}
+	return parseKlines(data)
+}
+
+// parseKlines decode a klines response
+func parseKlines(data []byte) (res []*Kline, err error) {
 	j, err := newJSON(data)
 	if err != nil {
 		return []*Kline{}, err
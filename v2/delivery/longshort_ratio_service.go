@@ -0,0 +1,227 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// LongShortRatioService list the long/short account ratio of all traders of a pair
+type LongShortRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *LongShortRatioService) Pair(pair string) *LongShortRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period interval
+func (s *LongShortRatioService) Period(period string) *LongShortRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *LongShortRatioService) Limit(limit int) *LongShortRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *LongShortRatioService) StartTime(startTime int64) *LongShortRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *LongShortRatioService) EndTime(endTime int64) *LongShortRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *LongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/globalLongShortAccountRatio",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	return res, nil
+}
+
+// LongShortRatio define long/short ratio info. LongPosition and
+// ShortPosition are set by TopLongShortPositionRatioService, LongAccount and
+// ShortAccount by the account ratio services.
+type LongShortRatio struct {
+	Pair           string `json:"pair"`
+	LongShortRatio string `json:"longShortRatio"`
+	LongAccount    string `json:"longAccount"`
+	ShortAccount   string `json:"shortAccount"`
+	LongPosition   string `json:"longPosition"`
+	ShortPosition  string `json:"shortPosition"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// TopLongShortAccountRatioService list the long/short account ratio of the top traders of a pair
+type TopLongShortAccountRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *TopLongShortAccountRatioService) Pair(pair string) *TopLongShortAccountRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period interval
+func (s *TopLongShortAccountRatioService) Period(period string) *TopLongShortAccountRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TopLongShortAccountRatioService) Limit(limit int) *TopLongShortAccountRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TopLongShortAccountRatioService) StartTime(startTime int64) *TopLongShortAccountRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TopLongShortAccountRatioService) EndTime(endTime int64) *TopLongShortAccountRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TopLongShortAccountRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/topLongShortAccountRatio",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	return res, nil
+}
+
+// TopLongShortPositionRatioService list the long/short position ratio of the top traders of a pair
+type TopLongShortPositionRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *TopLongShortPositionRatioService) Pair(pair string) *TopLongShortPositionRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period interval
+func (s *TopLongShortPositionRatioService) Period(period string) *TopLongShortPositionRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TopLongShortPositionRatioService) Limit(limit int) *TopLongShortPositionRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TopLongShortPositionRatioService) StartTime(startTime int64) *TopLongShortPositionRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TopLongShortPositionRatioService) EndTime(endTime int64) *TopLongShortPositionRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TopLongShortPositionRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/topLongShortPositionRatio",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type longShortRatioServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestLongShortRatioService(t *testing.T) {
+	suite.Run(t, new(longShortRatioServiceTestSuite))
+}
+
+func (s *longShortRatioServiceTestSuite) TestLongShortRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "0.1960",
+			"longAccount": "0.6622",
+			"shortAccount": "0.3378",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":      "BTCUSD",
+			"period":    "5m",
+			"limit":     10,
+			"startTime": 1583139600000,
+			"endTime":   1583139900000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewLongShortRatioService().Pair("BTCUSD").Period("5m").Limit(10).
+		StartTime(1583139600000).EndTime(1583139900000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LongShortRatio{
+		{
+			Pair:           "BTCUSD",
+			LongShortRatio: "0.1960",
+			LongAccount:    "0.6622",
+			ShortAccount:   "0.3378",
+			Timestamp:      1583139600000,
+		},
+	}, res)
+}
+
+func (s *longShortRatioServiceTestSuite) TestTopLongShortAccountRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "1.8105",
+			"longAccount": "0.6442",
+			"shortAccount": "0.3558",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":   "BTCUSD",
+			"period": "1h",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewTopLongShortAccountRatioService().Pair("BTCUSD").Period("1h").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal("0.6442", res[0].LongAccount)
+	r.Equal("0.3558", res[0].ShortAccount)
+}
+
+func (s *longShortRatioServiceTestSuite) TestTopLongShortPositionRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "0.7869",
+			"longPosition": "0.4404",
+			"shortPosition": "0.5596",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":   "BTCUSD",
+			"period": "1d",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewTopLongShortPositionRatioService().Pair("BTCUSD").Period("1d").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LongShortRatio{
+		{
+			Pair:           "BTCUSD",
+			LongShortRatio: "0.7869",
+			LongPosition:   "0.4404",
+			ShortPosition:  "0.5596",
+			Timestamp:      1583139600000,
+		},
+	}, res)
+}
@@ -0,0 +1,61 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type markPriceKlineServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarkPriceKlineService(t *testing.T) {
+	suite.Run(t, new(markPriceKlineServiceTestSuite))
+}
+
+func (s *markPriceKlineServiceTestSuite) TestKlines() {
+	data := []byte(`[
+		[
+			1591256400000,
+			"9653.69440000",
+			"9653.69640000",
+			"9651.38600000",
+			"9651.55200000",
+			"0",
+			1591256459999,
+			"0",
+			60,
+			"0",
+			"0",
+			"0"
+		]
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    "BTCUSD_PERP",
+			"interval":  "1m",
+			"limit":     1,
+			"startTime": 1591256400000,
+			"endTime":   1591256459999,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewMarkPriceKlinesService().Symbol("BTCUSD_PERP").Interval("1m").Limit(1).
+		StartTime(1591256400000).EndTime(1591256459999).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Kline{
+		{
+			OpenTime:  1591256400000,
+			Open:      "9653.69440000",
+			High:      "9653.69640000",
+			Low:       "9651.38600000",
+			Close:     "9651.55200000",
+			CloseTime: 1591256459999,
+		},
+	}, res)
+}
@@ -0,0 +1,130 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type markPriceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarkPriceService(t *testing.T) {
+	suite.Run(t, new(markPriceServiceTestSuite))
+}
+
+func (s *markPriceServiceTestSuite) TestPremiumIndex() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"markPrice": "11029.69574559",
+			"indexPrice": "10979.14437500",
+			"estimatedSettlePrice": "10981.74168236",
+			"lastFundingRate": "0.00071003",
+			"interestRate": "0.00010000",
+			"nextFundingTime": 1596096000000,
+			"time": 1596094042000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("pair", "BTCUSD")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewPremiumIndexService().Pair("BTCUSD").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*PremiumIndex{
+		{
+			Symbol:               "BTCUSD_PERP",
+			Pair:                 "BTCUSD",
+			MarkPrice:            "11029.69574559",
+			IndexPrice:           "10979.14437500",
+			EstimatedSettlePrice: "10981.74168236",
+			LastFundingRate:      "0.00071003",
+			InterestRate:         "0.00010000",
+			NextFundingTime:      1596096000000,
+			Time:                 1596094042000,
+		},
+	}, res)
+}
+
+func (s *markPriceServiceTestSuite) TestFundingRate() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"fundingTime": 1596038400000,
+			"fundingRate": "-0.00300000"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    "BTCUSD_PERP",
+			"startTime": 1596038400000,
+			"endTime":   1596038400001,
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewFundingRateService().Symbol("BTCUSD_PERP").StartTime(1596038400000).
+		EndTime(1596038400001).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*FundingRate{
+		{
+			Symbol:      "BTCUSD_PERP",
+			FundingRate: "-0.00300000",
+			FundingTime: 1596038400000,
+		},
+	}, res)
+}
+
+func (s *markPriceServiceTestSuite) TestGetLeverageBracket() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"notionalCoef": 1.50,
+			"brackets": [
+				{
+					"bracket": 1,
+					"initialLeverage": 125,
+					"qtyCap": 50,
+					"qtyFloor": 0,
+					"maintMarginRatio": 0.004,
+					"cum": 0.0
+				}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", "BTCUSD_PERP")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetLeverageBracketService().Symbol("BTCUSD_PERP").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LeverageBracket{
+		{
+			Symbol:       "BTCUSD_PERP",
+			NotionalCoef: 1.5,
+			Brackets: []Bracket{
+				{
+					Bracket:          1,
+					InitialLeverage:  125,
+					QtyCap:           50,
+					MaintMarginRatio: 0.004,
+				},
+			},
+		},
+	}, res)
+}
@@ -0,0 +1,193 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ModifyOrderService modify the price or quantity of an open LIMIT order in
+// place, at least one of them must be set
+type ModifyOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	side              SideType
+	quantity          string
+	price             string
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side, it must be the side of the order
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = price
+	return s
+}
+
+func (s *ModifyOrderService) params() params {
+	m := params{
+		"symbol": s.symbol,
+		"side":   s.side,
+	}
+	if s.quantity != "" {
+		m["quantity"] = s.quantity
+	}
+	if s.price != "" {
+		m["price"] = s.price
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	return m
+}
+
+// Do send request
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/dapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.params())
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ModifyBatchOrdersService modify up to 5 orders in one request
+type ModifyBatchOrdersService struct {
+	c      *Client
+	orders []*ModifyOrderService
+}
+
+// ModifyBatchOrdersResponse define modify batch orders response
+type ModifyBatchOrdersResponse struct {
+	// Orders hold the modified orders, the failed ones are skipped
+	Orders []*Order
+	// Results hold one result per order, in the order of OrderList
+	Results []*BatchOrderResult
+}
+
+// BatchOrderResult define the result of one order of a batch request,
+// exactly one of Order and Error is set
+type BatchOrderResult struct {
+	Order *Order
+	Error *common.APIError
+}
+
+// OrderList set the orders to modify
+func (s *ModifyBatchOrdersService) OrderList(orders []*ModifyOrderService) *ModifyBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request. A batch is rejected as a whole only on request errors,
+// the failure of an order is reported in its result.
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyBatchOrdersResponse, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	orders := []params{}
+	for _, order := range s.orders {
+		orders = append(orders, order.params())
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return &ModifyBatchOrdersResponse{}, err
+	}
+	r.setFormParams(params{
+		"batchOrders": string(b),
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return &ModifyBatchOrdersResponse{}, err
+	}
+	results, err := parseBatchOrderResults(data)
+	if err != nil {
+		return &ModifyBatchOrdersResponse{}, err
+	}
+	res = &ModifyBatchOrdersResponse{Results: results}
+	for _, result := range results {
+		if result.Order != nil {
+			res.Orders = append(res.Orders, result.Order)
+		}
+	}
+	return res, nil
+}
+
+// parseBatchOrderResults decode a batch response, where each element is
+// either an order or a {code,msg} error
+func parseBatchOrderResults(data []byte) ([]*BatchOrderResult, error) {
+	rawMessages := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(data, &rawMessages); err != nil {
+		return nil, err
+	}
+	results := make([]*BatchOrderResult, 0, len(rawMessages))
+	for _, raw := range rawMessages {
+		var probe struct {
+			Code *int64 `json:"code"`
+		}
+		if err := json.Unmarshal(raw, &probe); err != nil {
+			return nil, err
+		}
+		// successful orders don't have a code
+		if probe.Code != nil {
+			apiErr := new(common.APIError)
+			if err := json.Unmarshal(raw, apiErr); err != nil {
+				return nil, err
+			}
+			results = append(results, &BatchOrderResult{Error: apiErr})
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(raw, o); err != nil {
+			return nil, err
+		}
+		results = append(results, &BatchOrderResult{Order: o})
+	}
+	return results, nil
+}
@@ -0,0 +1,109 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type modifyOrderServiceTestSuite struct {
+	baseOrderTestSuite
+}
+
+func TestModifyOrderService(t *testing.T) {
+	suite.Run(t, new(modifyOrderServiceTestSuite))
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumBase": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":  "BTCUSD_PERP",
+			"orderId": 20072994037,
+			"side":    SideTypeBuy,
+			"price":   "30005",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").OrderID(20072994037).
+		Side(SideTypeBuy).Price("30005").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(20072994037), res.OrderID)
+	r.Equal("30005", res.Price)
+	r.Equal("1", res.OrigQuantity)
+	r.Equal(OrderStatusTypeNew, res.Status)
+	r.Equal(int64(1629182711600), res.UpdateTime)
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 42042723,
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"status": "NEW",
+			"clientOrderId": "Ne7DEEvLvv8b9Q6dF0eODy",
+			"price": "29000",
+			"origQty": "2",
+			"side": "BUY",
+			"type": "LIMIT",
+			"updateTime": 1629182711600
+		},
+		{
+			"code": -2013,
+			"msg": "Order does not exist."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"orderId":42042723,"price":"29000","quantity":"2","side":"BUY","symbol":"BTCUSD_PERP"},` +
+				`{"origClientOrderId":"myOrder2","quantity":"3","side":"SELL","symbol":"BTCUSD_PERP"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyBatchOrdersService().OrderList([]*ModifyOrderService{
+		s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").OrderID(42042723).
+			Side(SideTypeBuy).Quantity("2").Price("29000"),
+		s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").OrigClientOrderID("myOrder2").
+			Side(SideTypeSell).Quantity("3"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 1)
+	r.Equal(int64(42042723), res.Orders[0].OrderID)
+	r.Len(res.Results, 2)
+	r.Same(res.Orders[0], res.Results[0].Order)
+	r.Nil(res.Results[1].Order)
+	r.Equal(int64(-2013), res.Results[1].Error.Code)
+	r.Equal("Order does not exist.", res.Results[1].Error.Message)
+}
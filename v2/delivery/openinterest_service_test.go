@@ -0,0 +1,82 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type openInterestServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOpenInterestService(t *testing.T) {
+	suite.Run(t, new(openInterestServiceTestSuite))
+}
+
+func (s *openInterestServiceTestSuite) TestGetOpenInterest() {
+	data := []byte(`{
+		"symbol": "BTCUSD_200626",
+		"pair": "BTCUSD",
+		"openInterest": "15004",
+		"contractType": "CURRENT_QUARTER",
+		"time": 1591261042378
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", "BTCUSD_200626")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetOpenInterestService().Symbol("BTCUSD_200626").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&OpenInterest{
+		Symbol:       "BTCUSD_200626",
+		Pair:         "BTCUSD",
+		OpenInterest: "15004",
+		ContractType: ContractTypeCurrentQuarter,
+		Time:         1591261042378,
+	}, res)
+}
+
+func (s *openInterestServiceTestSuite) TestOpenInterestStatistics() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"contractType": "CURRENT_QUARTER",
+			"sumOpenInterest": "20403",
+			"sumOpenInterestValue": "176196512.23400000",
+			"timestamp": 1591261042378
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         "BTCUSD",
+			"contractType": ContractTypeCurrentQuarter,
+			"period":       "5m",
+			"limit":        10,
+			"startTime":    1591261000000,
+			"endTime":      1591262000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewOpenInterestStatisticsService().Pair("BTCUSD").
+		ContractType(ContractTypeCurrentQuarter).Period("5m").Limit(10).
+		StartTime(1591261000000).EndTime(1591262000000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*OpenInterestStatistic{
+		{
+			Pair:                 "BTCUSD",
+			ContractType:         ContractTypeCurrentQuarter,
+			SumOpenInterest:      "20403",
+			SumOpenInterestValue: "176196512.23400000",
+			Timestamp:            1591261042378,
+		},
+	}, res)
+}
@@ -3,7 +3,9 @@ package delivery
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
 // CreateOrderService create order
@@ -123,12 +125,7 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
-	}
+func (s *CreateOrderService) params() params {
 	m := params{
 		"symbol":           s.symbol,
 		"side":             s.side,
@@ -169,7 +166,16 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
-	r.setFormParams(m)
+	return m
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.params())
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -534,6 +540,64 @@ func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...RequestOpti
 	return nil
 }
 
+// CancelMultiplesOrdersService cancel a list of orders
+type CancelMultiplesOrdersService struct {
+	c                     *Client
+	symbol                string
+	orderIDList           []int64
+	origClientOrderIDList []string
+}
+
+// Symbol set symbol
+func (s *CancelMultiplesOrdersService) Symbol(symbol string) *CancelMultiplesOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIDList set orderIDList
+func (s *CancelMultiplesOrdersService) OrderIDList(orderIDList []int64) *CancelMultiplesOrdersService {
+	s.orderIDList = orderIDList
+	return s
+}
+
+// OrigClientOrderIDList set origClientOrderIDList
+func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDList []string) *CancelMultiplesOrdersService {
+	s.origClientOrderIDList = origClientOrderIDList
+	return s
+}
+
+// Do send request
+func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*CancelOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("symbol", s.symbol)
+	if s.orderIDList != nil {
+		// convert a slice of integers to a string e.g. [1 2 3] => "[1,2,3]"
+		orderIDListString := strings.Join(strings.Fields(fmt.Sprint(s.orderIDList)), ",")
+		r.setFormParam("orderIdList", orderIDListString)
+	}
+	if s.origClientOrderIDList != nil {
+		b, err := json.Marshal(s.origClientOrderIDList)
+		if err != nil {
+			return []*CancelOrderResponse{}, err
+		}
+		r.setFormParam("origClientOrderIdList", string(b))
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*CancelOrderResponse{}, err
+	}
+	res = make([]*CancelOrderResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*CancelOrderResponse{}, err
+	}
+	return res, nil
+}
+
 // ListLiquidationOrdersService list liquidation orders
 type ListLiquidationOrdersService struct {
 	c         *Client
@@ -621,3 +685,75 @@ type LiquidationOrder struct {
 	Side             SideType        `json:"side"`
 	Time             int64           `json:"time"`
 }
+
+// maxBatchOrders is the maximum number of orders of a batchOrders request
+const maxBatchOrders = 5
+
+// CreateBatchOrdersService create multiple orders, the orders are sent in
+// batches of 5, the maximum allowed per request
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// CreateBatchOrdersResponse define create batch orders response
+type CreateBatchOrdersResponse struct {
+	// Orders hold the created orders, the failed ones are skipped
+	Orders []*Order
+	// Results hold one result per order, in the order of OrderList
+	Results []*BatchOrderResult
+}
+
+// OrderList set the orders to create
+func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request. The failure of an order is reported in its result. If a
+// request fails, the results of the batches sent before it are returned
+// along with the error.
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	res = new(CreateBatchOrdersResponse)
+	for start := 0; start < len(s.orders); start += maxBatchOrders {
+		end := start + maxBatchOrders
+		if end > len(s.orders) {
+			end = len(s.orders)
+		}
+		results, err := s.createBatch(ctx, s.orders[start:end], opts...)
+		if err != nil {
+			return res, err
+		}
+		for _, result := range results {
+			res.Results = append(res.Results, result)
+			if result.Order != nil {
+				res.Orders = append(res.Orders, result.Order)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *CreateBatchOrdersService) createBatch(ctx context.Context, batch []*CreateOrderService, opts ...RequestOption) ([]*BatchOrderResult, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	orders := []params{}
+	for _, order := range batch {
+		orders = append(orders, order.params())
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r.setFormParams(params{
+		"batchOrders": string(b),
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return parseBatchOrderResults(data)
+}
@@ -1,6 +1,8 @@
 package delivery
 
 import (
+	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -529,6 +531,88 @@ func (s *orderServiceTestSuite) TestCancelAllOpenOrders() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCancelMultipleOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "myOrder1",
+			"orderId": 283194212,
+			"origQty": "11",
+			"price": "0",
+			"side": "BUY",
+			"status": "CANCELED",
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"type": "TRAILING_STOP_MARKET",
+			"updateTime": 1571110484038
+		},
+		{
+			"code": -2011,
+			"msg": "Unknown order sent."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                "BTCUSD_200925",
+			"orderIdList":           "[283194212,283194213]",
+			"origClientOrderIdList": `["myOrder1"]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCancelMultipleOrdersService().Symbol("BTCUSD_200925").
+		OrderIDList([]int64{283194212, 283194213}).OrigClientOrderIDList([]string{"myOrder1"}).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 2)
+	r.Equal(int64(283194212), res[0].OrderID)
+	r.Equal(OrderStatusTypeCanceled, res[0].Status)
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	s.client.Client.do = s.client.do
+	order := `{"orderId": 1, "symbol": "BTCUSD_PERP", "pair": "BTCUSD", "status": "NEW", "side": "BUY", "type": "MARKET"}`
+	first := `[` + order + `,` + order + `,` + order + `,` + order +
+		`,{"code": -2019, "msg": "Margin is insufficient."}]`
+	second := `[` + order + `]`
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(first), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(second), http.StatusOK), nil).Once()
+
+	var batches [][]params
+	s.assertReq(func(r *request) {
+		var orders []params
+		s.r().NoError(json.Unmarshal([]byte(r.form.Get("batchOrders")), &orders))
+		batches = append(batches, orders)
+	})
+	orders := make([]*CreateOrderService, 6)
+	for i := range orders {
+		orders[i] = s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeBuy).
+			Type(OrderTypeMarket).Quantity("1").PositionSide(PositionSideTypeLong)
+	}
+	res, err := s.client.NewCreateBatchOrdersService().OrderList(orders).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(batches, 2)
+	r.Len(batches[0], 5)
+	r.Len(batches[1], 1)
+	r.Equal(params{
+		"symbol":           "BTCUSD_PERP",
+		"side":             "BUY",
+		"type":             "MARKET",
+		"quantity":         "1",
+		"positionSide":     "LONG",
+		"newOrderRespType": "",
+	}, batches[1][0])
+	r.Len(res.Results, 6)
+	r.Len(res.Orders, 5)
+	r.Nil(res.Results[4].Order)
+	r.Equal(int64(-2019), res.Results[4].Error.Code)
+	r.Same(res.Results[5].Order, res.Orders[4])
+	r.Equal("BTCUSD", res.Orders[4].Pair)
+}
+
 func (s *orderServiceTestSuite) TestListLiquidationOrders() {
 	data := []byte(`[
 		{
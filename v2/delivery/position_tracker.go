@@ -0,0 +1,178 @@
+package delivery
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// TrackedPosition define a position kept up to date by a PositionTracker,
+// its PnL is in the margin asset of the contract
+type TrackedPosition = common.TrackedPosition[PositionSideType, MarginType]
+
+// PositionChangeHandler handle a position change
+type PositionChangeHandler func(p TrackedPosition)
+
+type positionRecord = common.PositionRecord[PositionSideType, MarginType]
+
+// PositionTracker keep the positions of the account from a REST snapshot
+// and the ACCOUNT_UPDATE and ORDER_TRADE_UPDATE events of the user data
+// stream, marked to the mark price stream.
+//
+// The user data stream is not resumed after a disconnection: once its
+// doneC is closed, call ServeUserData again with a valid listen key and the
+// tracker resyncs from a new snapshot.
+type PositionTracker struct {
+	*common.PositionBook[PositionSideType, MarginType]
+	c          *Client
+	pair       string
+	errHandler ErrHandler
+
+	sizesMu sync.Mutex
+	sizes   map[string]common.Decimal
+}
+
+// Pair set pair, to track the positions of the contracts of a single pair
+func (t *PositionTracker) Pair(pair string) *PositionTracker {
+	t.pair = pair
+	return t
+}
+
+// OnChange set the handler called with every position that changed
+func (t *PositionTracker) OnChange(handler PositionChangeHandler) *PositionTracker {
+	t.PositionBook.OnChange(handler)
+	return t
+}
+
+// OnError set the handler of errors met while handling stream events
+func (t *PositionTracker) OnError(errHandler ErrHandler) *PositionTracker {
+	t.errHandler = errHandler
+	return t
+}
+
+func (t *PositionTracker) tracks(symbol string) bool {
+	return t.pair == "" || strings.HasPrefix(symbol, t.pair+"_")
+}
+
+func (t *PositionTracker) handleErr(err error) {
+	if err != nil && t.errHandler != nil {
+		t.errHandler(err)
+	}
+}
+
+// Sync replace the positions with a REST snapshot. ACCOUNT_UPDATE events
+// received meanwhile are applied on top of it if they are newer than the
+// request, the realised PnL is kept. The contract sizes of new symbols are
+// read from the exchange info.
+func (t *PositionTracker) Sync(ctx context.Context, opts ...RequestOption) error {
+	return t.SyncRecords(func() ([]positionRecord, int64, error) {
+		requestTime := currentTimestamp() - t.c.TimeOffset
+		s := t.c.NewGetPositionRiskService()
+		if t.pair != "" {
+			s.Pair(t.pair)
+		}
+		res, err := s.Do(ctx, opts...)
+		if err != nil {
+			return nil, requestTime, err
+		}
+		snapshot := snapshotRecords(res)
+		return snapshot, requestTime, t.fetchContractSizes(ctx, snapshot, opts...)
+	})
+}
+
+// fetchContractSizes fetch the contract sizes of the snapshot symbols not known yet
+func (t *PositionTracker) fetchContractSizes(ctx context.Context, snapshot []positionRecord, opts ...RequestOption) error {
+	t.sizesMu.Lock()
+	missing := false
+	for _, p := range snapshot {
+		if _, ok := t.sizes[p.Symbol]; !ok {
+			missing = true
+			break
+		}
+	}
+	t.sizesMu.Unlock()
+	if !missing {
+		return nil
+	}
+	info, err := t.c.NewExchangeInfoService().Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	t.sizesMu.Lock()
+	defer t.sizesMu.Unlock()
+	for _, s := range info.Symbols {
+		t.sizes[s.Symbol] = common.NewDecimalFromInt(int64(s.ContractSize))
+	}
+	return nil
+}
+
+func snapshotRecords(res []*PositionRisk) []positionRecord {
+	records := make([]positionRecord, 0, len(res))
+	for _, r := range res {
+		records = append(records, positionRecord{
+			Symbol:       r.Symbol,
+			PositionSide: PositionSideType(r.PositionSide),
+			Amount:       r.PositionAmt,
+			EntryPrice:   r.EntryPrice,
+			MarkPrice:    r.MarkPrice,
+			MarginType:   MarginType(r.MarginType),
+		})
+	}
+	return records
+}
+
+// HandleUserData apply the ACCOUNT_UPDATE and ORDER_TRADE_UPDATE events
+func (t *PositionTracker) HandleUserData(event *WsUserDataEvent) {
+	switch event.Event {
+	case UserDataEventTypeAccountUpdate:
+		records := make([]positionRecord, 0, len(event.AccountUpdate.Positions))
+		for _, u := range event.AccountUpdate.Positions {
+			records = append(records, positionRecord{
+				Symbol:       u.Symbol,
+				PositionSide: u.Side,
+				Amount:       u.Amount,
+				EntryPrice:   u.EntryPrice,
+				MarginType:   u.MarginType,
+			})
+		}
+		t.handleErr(t.ApplyAccountRecords(event.TransactionTime, records))
+	case UserDataEventTypeOrderTradeUpdate:
+		u := event.OrderTradeUpdate
+		t.handleErr(t.ApplyTradeRecord(u.Symbol, u.PositionSide, u.TradeID, u.RealizedPnL))
+	}
+}
+
+// HandleMarkPrice mark the positions of the event symbol to its mark price
+func (t *PositionTracker) HandleMarkPrice(event *WsMarkPriceEvent) {
+	t.handleErr(t.ApplyMarkPriceRecord(event.Symbol, event.MarkPrice))
+}
+
+// ServeUserData serve the user data stream of listenKey into the tracker
+// and resync it once connected
+func (t *PositionTracker) ServeUserData(ctx context.Context, listenKey string) (doneC, stopC chan struct{}, err error) {
+	return common.ServeSynced(func() (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, t.HandleUserData, t.handleErr)
+	}, func() error {
+		return t.Sync(ctx)
+	})
+}
+
+// ServeMarkPrice serve the mark price stream of symbol into the tracker
+func (t *PositionTracker) ServeMarkPrice(symbol string) (doneC, stopC chan struct{}, err error) {
+	return WsMarkPriceServe(symbol, t.HandleMarkPrice, t.handleErr)
+}
+
+// unrealizedPnL return the profit in the margin asset of closing the position
+// at the mark price, amount * contract size * (1 / entry price - 1 / mark price)
+func (t *PositionTracker) unrealizedPnL(p *TrackedPosition) common.Decimal {
+	t.sizesMu.Lock()
+	size, ok := t.sizes[p.Symbol]
+	t.sizesMu.Unlock()
+	if !ok || p.Amount.IsZero() || p.MarkPrice.IsZero() || p.EntryPrice.IsZero() {
+		return common.Decimal{}
+	}
+	return p.Amount.Mul(size).Mul(p.MarkPrice.Sub(p.EntryPrice)).
+		Div(p.EntryPrice.Mul(p.MarkPrice), 8, common.RoundHalfEven).Trim()
+}
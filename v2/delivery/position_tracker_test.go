@@ -0,0 +1,120 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type positionTrackerTestSuite struct {
+	baseTestSuite
+	tracker *PositionTracker
+	changes []TrackedPosition
+	errs    []error
+}
+
+func TestPositionTracker(t *testing.T) {
+	suite.Run(t, new(positionTrackerTestSuite))
+}
+
+func (s *positionTrackerTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.client.Client.do = s.client.do
+	s.changes = nil
+	s.errs = nil
+	s.tracker = s.client.NewPositionTracker().
+		OnChange(func(p TrackedPosition) { s.changes = append(s.changes, p) }).
+		OnError(func(err error) { s.errs = append(s.errs, err) })
+}
+
+func (s *positionTrackerTestSuite) position(symbol string, positionSide PositionSideType) TrackedPosition {
+	p, ok := s.tracker.Position(symbol, positionSide)
+	s.r().True(ok)
+	return p
+}
+
+const (
+	positionTrackerSnapshot = `[
+		{"symbol": "BTCUSD_PERP", "positionSide": "BOTH", "positionAmt": "10", "entryPrice": "50000", "markPrice": "55000", "marginType": "cross"}
+	]`
+	positionTrackerExchangeInfo = `{"symbols": [
+		{"symbol": "BTCUSD_PERP", "pair": "BTCUSD", "contractSize": 100},
+		{"symbol": "ETHUSD_PERP", "pair": "ETHUSD", "contractSize": 10}
+	]}`
+)
+
+func (s *positionTrackerTestSuite) sync() {
+	s.mockDoOnce([]byte(positionTrackerSnapshot), nil)
+	s.mockDoOnce([]byte(positionTrackerExchangeInfo), nil)
+	s.r().NoError(s.tracker.Sync(newContext()))
+}
+
+func (s *positionTrackerTestSuite) TestSync() {
+	s.tracker.Pair("BTCUSD")
+	s.assertReq(func(r *request) {
+		if r.query.Get("pair") != "" {
+			s.assertRequestEqual(newSignedRequest().setParam("pair", "BTCUSD"), r)
+		}
+	})
+	s.sync()
+	s.r().Equal("/dapi/v1/positionRisk", s.calledRequest(0).URL.Path)
+	s.r().Equal("/dapi/v1/exchangeInfo", s.calledRequest(1).URL.Path)
+
+	p := s.position("BTCUSD_PERP", PositionSideTypeBoth)
+	s.assertDecimal("10", p.Amount)
+	// 10 contracts of 100 USD from 50000 to 55000
+	s.assertDecimal("0.00181818", p.UnrealizedPnL)
+	s.r().Len(s.changes, 1)
+
+	// the contract sizes are known now
+	s.mockDoOnce([]byte(positionTrackerSnapshot), nil)
+	s.r().NoError(s.tracker.Sync(newContext()))
+	s.r().Len(s.client.Calls, 3)
+	s.r().Len(s.changes, 1)
+}
+
+func (s *positionTrackerTestSuite) TestAccountUpdateAndMarkPrice() {
+	s.tracker.Pair("BTCUSD")
+	s.sync()
+	s.changes = nil
+	now := currentTimestamp()
+
+	s.tracker.HandleUserData(&WsUserDataEvent{
+		Event:           UserDataEventTypeAccountUpdate,
+		TransactionTime: now + 1000,
+		AccountUpdate: WsAccountUpdate{Positions: []WsPosition{
+			{Symbol: "BTCUSD_PERP", Side: PositionSideTypeBoth, Amount: "-20", EntryPrice: "55000"},
+			{Symbol: "ETHUSD_PERP", Side: PositionSideTypeBoth, Amount: "1", EntryPrice: "3000"},
+		}},
+	})
+	p := s.position("BTCUSD_PERP", PositionSideTypeBoth)
+	s.assertDecimal("-20", p.Amount)
+	s.assertDecimal("0", p.UnrealizedPnL)
+	// the positions of other pairs are not tracked
+	_, ok := s.tracker.Position("ETHUSD_PERP", PositionSideTypeBoth)
+	s.r().False(ok)
+
+	s.tracker.HandleMarkPrice(&WsMarkPriceEvent{Symbol: "BTCUSD_PERP", MarkPrice: "50000"})
+	// a short of 2000 USD from 55000 to 50000
+	s.assertDecimal("0.00363636", s.position("BTCUSD_PERP", PositionSideTypeBoth).UnrealizedPnL)
+	s.r().Len(s.changes, 2)
+	s.r().Len(s.tracker.Positions(), 1)
+}
+
+func (s *positionTrackerTestSuite) TestRealizedPnL() {
+	s.sync()
+	event := &WsUserDataEvent{
+		Event: UserDataEventTypeOrderTradeUpdate,
+		OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol:       "BTCUSD_PERP",
+			PositionSide: PositionSideTypeBoth,
+			TradeID:      12,
+			RealizedPnL:  "0.0005",
+		},
+	}
+	// a fill reported twice is counted once
+	s.tracker.HandleUserData(event)
+	s.tracker.HandleUserData(event)
+	s.assertDecimal("0.0005", s.position("BTCUSD_PERP", PositionSideTypeBoth).RealizedPnL)
+	s.r().Empty(s.errs)
+}
@@ -0,0 +1,96 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// TakerBuySellVolumeService list taker buy and sell volume of a pair and contract type
+type TakerBuySellVolumeService struct {
+	c            *Client
+	pair         string
+	contractType ContractType
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *TakerBuySellVolumeService) Pair(pair string) *TakerBuySellVolumeService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType
+func (s *TakerBuySellVolumeService) ContractType(contractType ContractType) *TakerBuySellVolumeService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period interval
+func (s *TakerBuySellVolumeService) Period(period string) *TakerBuySellVolumeService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TakerBuySellVolumeService) Limit(limit int) *TakerBuySellVolumeService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TakerBuySellVolumeService) StartTime(startTime int64) *TakerBuySellVolumeService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TakerBuySellVolumeService) EndTime(endTime int64) *TakerBuySellVolumeService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TakerBuySellVolumeService) Do(ctx context.Context, opts ...RequestOption) (res []*TakerBuySellVolume, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/takerBuySellVol",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("contractType", s.contractType)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*TakerBuySellVolume{}, err
+	}
+	res = make([]*TakerBuySellVolume, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TakerBuySellVolume{}, err
+	}
+	return res, nil
+}
+
+// TakerBuySellVolume define taker buy and sell volume info, volumes are in contracts and
+// values in the base asset
+type TakerBuySellVolume struct {
+	Pair              string       `json:"pair"`
+	ContractType      ContractType `json:"contractType"`
+	TakerBuyVol       string       `json:"takerBuyVol"`
+	TakerSellVol      string       `json:"takerSellVol"`
+	TakerBuyVolValue  string       `json:"takerBuyVolValue"`
+	TakerSellVolValue string       `json:"takerSellVolValue"`
+	Timestamp         int64        `json:"timestamp"`
+}
@@ -0,0 +1,55 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type takerVolumeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTakerVolumeService(t *testing.T) {
+	suite.Run(t, new(takerVolumeServiceTestSuite))
+}
+
+func (s *takerVolumeServiceTestSuite) TestTakerBuySellVolume() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"contractType": "PERPETUAL",
+			"takerBuyVol": "387",
+			"takerSellVol": "248",
+			"takerBuyVolValue": "2342.1220",
+			"takerSellVolValue": "4213.9800",
+			"timestamp": 1617866700000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         "BTCUSD",
+			"contractType": ContractTypePerpetual,
+			"period":       "5m",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewTakerBuySellVolumeService().Pair("BTCUSD").ContractType(ContractTypePerpetual).
+		Period("5m").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*TakerBuySellVolume{
+		{
+			Pair:              "BTCUSD",
+			ContractType:      ContractTypePerpetual,
+			TakerBuyVol:       "387",
+			TakerSellVol:      "248",
+			TakerBuyVolValue:  "2342.1220",
+			TakerSellVolValue: "4213.9800",
+			Timestamp:         1617866700000,
+		},
+	}, res)
+}
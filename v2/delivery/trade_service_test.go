@@ -0,0 +1,181 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type tradeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTradeService(t *testing.T) {
+	suite.Run(t, new(tradeServiceTestSuite))
+}
+
+func (s *tradeServiceTestSuite) TestAggregateTrades() {
+	data := []byte(`[
+		{
+			"a": 416690,
+			"p": "9642.4",
+			"q": "3",
+			"f": 595259,
+			"l": 595259,
+			"T": 1591250548649,
+			"m": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    "BTCUSD_PERP",
+			"fromId":    1,
+			"startTime": 1591250548000,
+			"endTime":   1591250549000,
+			"limit":     1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewAggTradesService().Symbol("BTCUSD_PERP").FromID(1).
+		StartTime(1591250548000).EndTime(1591250549000).Limit(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*AggTrade{
+		{
+			AggTradeID:   416690,
+			Price:        "9642.4",
+			Quantity:     "3",
+			FirstTradeID: 595259,
+			LastTradeID:  595259,
+			Timestamp:    1591250548649,
+		},
+	}, res)
+}
+
+func (s *tradeServiceTestSuite) TestRecentTrades() {
+	data := []byte(`[
+		{
+			"id": 28457,
+			"price": "9635.0",
+			"qty": "1",
+			"baseQty": "0.01037883",
+			"time": 1591250192508,
+			"isBuyerMaker": true
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "BTCUSD_PERP",
+			"limit":  1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewRecentTradesService().Symbol("BTCUSD_PERP").Limit(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Trade{
+		{
+			ID:           28457,
+			Price:        "9635.0",
+			Quantity:     "1",
+			BaseQuantity: "0.01037883",
+			Time:         1591250192508,
+			IsBuyerMaker: true,
+		},
+	}, res)
+}
+
+func (s *tradeServiceTestSuite) TestHistoricalTrades() {
+	data := []byte(`[
+		{
+			"id": 28457,
+			"price": "9635.0",
+			"qty": "1",
+			"baseQty": "0.01037883",
+			"time": 1591250192508,
+			"isBuyerMaker": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "BTCUSD_PERP",
+			"limit":  1,
+			"fromId": 28457,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewHistoricalTradesService().Symbol("BTCUSD_PERP").Limit(1).
+		FromID(28457).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(int64(28457), res[0].ID)
+	r.Equal("0.01037883", res[0].BaseQuantity)
+}
+
+func (s *tradeServiceTestSuite) TestListAccountTrades() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200626",
+			"id": 6,
+			"orderId": 28,
+			"pair": "BTCUSD",
+			"side": "SELL",
+			"price": "8800",
+			"qty": "1",
+			"realizedPnl": "0",
+			"marginAsset": "BTC",
+			"baseQty": "0.01136364",
+			"commission": "0.00000454",
+			"commissionAsset": "BTC",
+			"time": 1590743483586,
+			"positionSide": "BOTH",
+			"buyer": false,
+			"maker": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"pair":      "BTCUSD",
+			"startTime": 1590743483000,
+			"endTime":   1590743484000,
+			"fromId":    5,
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListAccountTradeService().Pair("BTCUSD").StartTime(1590743483000).
+		EndTime(1590743484000).FromID(5).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*AccountTrade{
+		{
+			Symbol:          "BTCUSD_200626",
+			ID:              6,
+			OrderID:         28,
+			Pair:            "BTCUSD",
+			Side:            SideTypeSell,
+			Price:           "8800",
+			Quantity:        "1",
+			RealizedPnl:     "0",
+			MarginAsset:     "BTC",
+			BaseQuantity:    "0.01136364",
+			Commission:      "0.00000454",
+			CommissionAsset: "BTC",
+			Time:            1590743483586,
+			PositionSide:    PositionSideTypeBoth,
+		},
+	}, res)
+}
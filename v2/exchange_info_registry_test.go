@@ -0,0 +1,240 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type exchangeInfoRegistryTestSuite struct {
+	baseTestSuite
+}
+
+func TestExchangeInfoRegistry(t *testing.T) {
+	suite.Run(t, new(exchangeInfoRegistryTestSuite))
+}
+
+const registryExchangeInfo = `{
+	"timezone": "UTC",
+	"serverTime": 1565246363776,
+	"rateLimits": [],
+	"exchangeFilters": [],
+	"symbols": [
+		{
+			"symbol": "ETHBTC",
+			"status": "TRADING",
+			"baseAsset": "ETH",
+			"quoteAsset": "BTC",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "0.00001000", "maxPrice": "922327.00000000", "tickSize": "0.00001000"},
+				{"filterType": "LOT_SIZE", "minQty": "0.00010000", "maxQty": "100000.00000000", "stepSize": "0.00010000"},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": "0.00000000", "maxQty": "2466.00000000", "stepSize": "0.00000000"},
+				{"filterType": "NOTIONAL", "minNotional": "0.00010000", "applyMinToMarket": true, "maxNotional": "9000000.00000000", "applyMaxToMarket": false, "avgPriceMins": 5}
+			]
+		},
+		{
+			"symbol": "ETHUSDT",
+			"status": "TRADING",
+			"baseAsset": "ETH",
+			"quoteAsset": "USDT",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.01000000"},
+				{"filterType": "LOT_SIZE", "minQty": "0.00010000", "maxQty": "9000.00000000", "stepSize": "0.00010000"},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": "0.00000000", "maxQty": "1000.00000000", "stepSize": "0.00100000"}
+			]
+		},
+		{
+			"symbol": "BTCUSDT",
+			"status": "BREAK",
+			"baseAsset": "BTC",
+			"quoteAsset": "USDT",
+			"filters": []
+		}
+	]
+}`
+
+func (s *exchangeInfoRegistryTestSuite) mockDoOnce(data string, statusCode ...int) {
+	s.client.Client.do = s.client.do
+	code := http.StatusOK
+	if len(statusCode) > 0 {
+		code = statusCode[0]
+	}
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(data), code), nil).Once()
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestLookups() {
+	s.mockDoOnce(registryExchangeInfo)
+	registry := s.client.NewExchangeInfoRegistry(0)
+	r := s.r()
+
+	_, ok := registry.Lookup("ETHBTC")
+	r.False(ok)
+
+	symbol, err := registry.Symbol(newContext(), "ETHBTC")
+	r.NoError(err)
+	r.Equal("ETHBTC", symbol.Symbol.Symbol)
+	r.Equal("0.00001000", symbol.Price.TickSize)
+	r.Equal("0.00010000", symbol.LotSize.StepSize)
+	r.True(symbol.Notional.ApplyMinToMarket)
+	r.Nil(symbol.IcebergParts)
+
+	// the cache never expires with a zero TTL
+	_, err = registry.Symbol(newContext(), "ETHUSDT")
+	r.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+
+	_, err = registry.Symbol(newContext(), "LTCUSDT")
+	r.True(errors.Is(err, common.ErrSymbolNotFound))
+
+	names := func(symbols []*SymbolInfo) []string {
+		var res []string
+		for _, s := range symbols {
+			res = append(res, s.Symbol.Symbol)
+		}
+		return res
+	}
+	r.Equal([]string{"ETHBTC", "ETHUSDT", "BTCUSDT"}, names(registry.Symbols()))
+	r.Equal([]string{"ETHBTC", "ETHUSDT"}, names(registry.SymbolsByBaseAsset("ETH")))
+	r.Equal([]string{"ETHUSDT", "BTCUSDT"}, names(registry.SymbolsByQuoteAsset("USDT")))
+	r.Equal([]string{"BTCUSDT"}, names(registry.SymbolsByStatus(SymbolStatusTypeBreak)))
+	r.Empty(registry.SymbolsByQuoteAsset("BNB"))
+	r.False(registry.UpdateTime().IsZero())
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestQuantize() {
+	s.mockDoOnce(registryExchangeInfo)
+	registry := s.client.NewExchangeInfoRegistry(0)
+	r := s.r()
+
+	symbol, err := registry.Symbol(newContext(), "ETHUSDT")
+	r.NoError(err)
+	r.Equal("1834.56000000", symbol.QuantizePrice(common.MustParseDecimal("1834.5678"), common.RoundDown).String())
+	r.Equal("1834.57000000", symbol.QuantizePrice(common.MustParseDecimal("1834.5678"), common.RoundHalfUp).String())
+	r.Equal("0.12340000", symbol.QuantizeQuantity(common.MustParseDecimal("0.123456"), common.RoundDown).String())
+	r.Equal("0.12300000", symbol.QuantizeMarketQuantity(common.MustParseDecimal("0.123456"), common.RoundDown).String())
+
+	// no MARKET_LOT_SIZE step, fall back to LOT_SIZE
+	symbol, err = registry.Symbol(newContext(), "ETHBTC")
+	r.NoError(err)
+	r.Equal("0.12340000", symbol.QuantizeMarketQuantity(common.MustParseDecimal("0.123456"), common.RoundDown).String())
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestRefreshOnInvalidSymbol() {
+	s.mockDoOnce(registryExchangeInfo)
+	s.mockDoOnce(`{"symbols": [{"symbol": "LTCUSDT", "status": "TRADING", "baseAsset": "LTC", "quoteAsset": "USDT", "filters": []}]}`)
+	registry := s.client.NewExchangeInfoRegistry(time.Hour)
+	r := s.r()
+
+	_, err := registry.Symbol(newContext(), "ETHBTC")
+	r.NoError(err)
+
+	r.False(registry.ObserveError(errors.New("network error")))
+	r.False(registry.ObserveError(&common.APIError{Code: -2010, Message: "insufficient balance"}))
+	r.True(registry.ObserveError(&common.APIError{Code: -1121, Message: "Invalid symbol."}))
+
+	symbol, err := registry.Symbol(newContext(), "LTCUSDT")
+	r.NoError(err)
+	r.Equal("LTC", symbol.BaseAsset)
+	_, ok := registry.Lookup("ETHBTC")
+	r.False(ok)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestRefreshOnClientError() {
+	s.mockDoOnce(registryExchangeInfo)
+	s.mockDoOnce(`{"code": -1121, "msg": "Invalid symbol."}`, http.StatusBadRequest)
+	s.mockDoOnce(registryExchangeInfo)
+	registry := s.client.NewExchangeInfoRegistry(time.Hour)
+	r := s.r()
+
+	_, err := registry.Symbol(newContext(), "ETHBTC")
+	r.NoError(err)
+	_, err = s.client.NewDepthService().Symbol("LTCBTC").Do(newContext())
+	r.True(common.IsInvalidSymbolError(err))
+
+	_, err = registry.Symbol(newContext(), "ETHBTC")
+	r.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestClose() {
+	s.mockDoOnce(registryExchangeInfo)
+	s.mockDoOnce(`{"code": -1121, "msg": "Invalid symbol."}`, http.StatusBadRequest)
+	registry := s.client.NewExchangeInfoRegistry(time.Hour)
+	r := s.r()
+
+	_, err := registry.Symbol(newContext(), "ETHBTC")
+	r.NoError(err)
+	registry.Close()
+	_, err = s.client.NewDepthService().Symbol("LTCBTC").Do(newContext())
+	r.True(common.IsInvalidSymbolError(err))
+
+	// the cache is kept
+	_, err = registry.Symbol(newContext(), "ETHBTC")
+	r.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestTTL() {
+	s.mockDoOnce(registryExchangeInfo)
+	s.mockDoOnce(registryExchangeInfo)
+	registry := s.client.NewExchangeInfoRegistry(time.Millisecond)
+	r := s.r()
+
+	_, err := registry.ExchangeInfo(newContext())
+	r.NoError(err)
+	time.Sleep(2 * time.Millisecond)
+	info, err := registry.ExchangeInfo(newContext())
+	r.NoError(err)
+	r.Len(info.Symbols, 3)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestAutoRefresh() {
+	s.mockDoOnce(registryExchangeInfo)
+	s.mockDoOnce(`{"code": -1003, "msg": "Too many requests."}`, http.StatusTooManyRequests)
+	registry := s.client.NewExchangeInfoRegistry(0)
+	r := s.r()
+
+	errC := make(chan error, 1)
+	_, _, err := registry.StartAutoRefresh(0, nil)
+	r.Error(err)
+	doneC, stopC, err := registry.StartAutoRefresh(time.Hour, func(err error) {
+		errC <- err
+	})
+	r.NoError(err)
+	registry.Invalidate()
+	select {
+	case err := <-errC:
+		s.T().Fatalf("unexpected error: %v", err)
+	case <-time.After(time.Second):
+		s.T().Fatal("refresh was not triggered")
+	case <-waitFor(func() bool { _, ok := registry.Lookup("ETHBTC"); return ok }):
+	}
+
+	registry.Invalidate()
+	select {
+	case err := <-errC:
+		r.True(common.IsAPIError(err))
+	case <-time.After(time.Second):
+		s.T().Fatal("refresh was not triggered")
+	}
+	close(stopC)
+	<-doneC
+}
+
+// waitFor return a channel closed once cond returns true
+func waitFor(cond func() bool) chan struct{} {
+	c := make(chan struct{})
+	go func() {
+		for !cond() {
+			time.Sleep(time.Millisecond)
+		}
+		close(c)
+	}()
+	return c
+}
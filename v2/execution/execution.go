@@ -0,0 +1,601 @@
+// Package execution slice large parent orders into LIMIT child orders placed
+// over time (TWAP, VWAP) or one at a time (iceberg), on the spot, margin or
+// USDⓈ-M futures account.
+//
+// Executors learn about fills from the user data stream: feed every
+// execution report to HandleFill, for instance through SpotFill or
+// FuturesFill.
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Side define side of a parent order
+type Side string
+
+// State define the state of an executor
+type State string
+
+// Global enums
+const (
+	SideBuy  Side = "BUY"
+	SideSell Side = "SELL"
+
+	StatePending   State = "PENDING"
+	StateRunning   State = "RUNNING"
+	StatePaused    State = "PAUSED"
+	StateDone      State = "DONE"
+	StateCancelled State = "CANCELLED"
+	StateFailed    State = "FAILED"
+
+	decimalPlaces = 8
+)
+
+// Fill define an execution report of a child order
+type Fill struct {
+	ClientOrderID string
+	OrderID       int64
+	// TradeID identify the trade reported, a report repeating a known trade
+	// is ignored. It is zero when the report is not a trade or has no id.
+	TradeID int64
+	// Quantity and Price of the last trade, zero when the report is not a trade
+	Quantity common.Decimal
+	Price    common.Decimal
+	Status   string
+}
+
+func (f Fill) terminal() bool {
+	switch f.Status {
+	case "FILLED", "CANCELED", "EXPIRED", "REJECTED", "EXPIRED_IN_MATCH":
+		return true
+	}
+	return false
+}
+
+// PriceFunc return the limit price of the next child order
+type PriceFunc func(ctx context.Context, side Side) (common.Decimal, error)
+
+// FixedPrice return a PriceFunc always quoting price
+func FixedPrice(price common.Decimal) PriceFunc {
+	return func(ctx context.Context, side Side) (common.Decimal, error) {
+		return price, nil
+	}
+}
+
+// Progress define a snapshot of an executor
+type Progress struct {
+	State     State
+	Quantity  common.Decimal
+	Filled    common.Decimal
+	Working   common.Decimal
+	Remaining common.Decimal
+	AvgPrice  common.Decimal
+	Children  int
+	Err       error
+}
+
+type child struct {
+	order      ChildOrder
+	orderID    int64
+	filled     common.Decimal
+	trades     map[int64]struct{}
+	done       bool
+	cancelling bool
+}
+
+func (c *child) working() common.Decimal {
+	return c.order.Quantity.Sub(c.filled)
+}
+
+// newTrade record tradeID, it return false if the trade was already applied
+func (c *child) newTrade(tradeID int64) bool {
+	if tradeID <= 0 {
+		return true
+	}
+	if _, ok := c.trades[tradeID]; ok {
+		return false
+	}
+	if c.trades == nil {
+		c.trades = make(map[int64]struct{})
+	}
+	c.trades[tradeID] = struct{}{}
+	return true
+}
+
+// Executor work a parent order by placing child orders on a venue.
+//
+// Time sliced executors (TWAP, VWAP) release a growing share of the parent
+// quantity at every slice, cancelling the children still resting in the book
+// so that their remainder rolls over into the new slice. After the last slice
+// the executor finishes once every child is filled or gone, leaving any
+// unfilled quantity in Progress.Remaining. Iceberg executors keep a single
+// child of the display quantity working until the parent is filled.
+type Executor struct {
+	venue    Venue
+	symbol   string
+	side     Side
+	quantity common.Decimal
+	filters  Filters
+	price    PriceFunc
+	limit    *common.Decimal
+	ioc      bool
+	prefix   string
+
+	// time sliced schedule: the cumulative weight released at each offset
+	offsets     []time.Duration
+	weights     []common.Decimal
+	totalWeight common.Decimal
+	// iceberg schedule
+	display common.Decimal
+
+	mu        sync.Mutex
+	state     State
+	err       error
+	startedAt time.Time
+	slice     int
+	placed    int
+	seq       int
+	filled    common.Decimal
+	notional  common.Decimal
+	children  map[string]*child
+	wakeC     chan struct{}
+	stopC     chan struct{}
+	stopOnce  sync.Once
+}
+
+func newExecutor(venue Venue, symbol string, side Side, quantity common.Decimal) *Executor {
+	return &Executor{
+		venue:    venue,
+		symbol:   symbol,
+		side:     side,
+		quantity: quantity,
+		prefix:   "x" + strconv.FormatInt(time.Now().UnixNano(), 36),
+		state:    StatePending,
+		children: make(map[string]*child),
+		wakeC:    make(chan struct{}, 1),
+		stopC:    make(chan struct{}),
+	}
+}
+
+// NewTWAP init an executor releasing quantity in equal slices evenly spread over duration
+func NewTWAP(venue Venue, symbol string, side Side, quantity common.Decimal, duration time.Duration, slices int) *Executor {
+	profile := make([]float64, slices)
+	for i := range profile {
+		profile[i] = 1
+	}
+	return NewVWAP(venue, symbol, side, quantity, duration, profile)
+}
+
+// NewVWAP init an executor releasing quantity over duration in as many slices
+// as profile has entries, each slice weighted by its expected volume
+func NewVWAP(venue Venue, symbol string, side Side, quantity common.Decimal, duration time.Duration, profile []float64) *Executor {
+	e := newExecutor(venue, symbol, side, quantity)
+	var total common.Decimal
+	for i, v := range profile {
+		w, err := common.NewDecimalFromFloat(v)
+		if err != nil || math.IsInf(v, 0) || w.IsNegative() {
+			e.err = fmt.Errorf("execution: invalid volume profile weight %v", v)
+			return e
+		}
+		total = total.Add(w)
+		e.offsets = append(e.offsets, duration*time.Duration(i)/time.Duration(len(profile)))
+		e.weights = append(e.weights, total)
+	}
+	e.totalWeight = total
+	if len(profile) == 0 || !total.IsPositive() {
+		e.err = errors.New("execution: volume profile must have a positive weight")
+	}
+	return e
+}
+
+// NewIceberg init an executor keeping a single child of displayQuantity in the book
+func NewIceberg(venue Venue, symbol string, side Side, quantity, displayQuantity common.Decimal) *Executor {
+	e := newExecutor(venue, symbol, side, quantity)
+	e.display = displayQuantity
+	if !displayQuantity.IsPositive() {
+		e.err = errors.New("execution: display quantity must be positive")
+	}
+	return e
+}
+
+// Filters set the symbol filters child orders are rounded to
+func (e *Executor) Filters(filters Filters) *Executor {
+	e.filters = filters
+	return e
+}
+
+// Price set the function pricing every child order
+func (e *Executor) Price(price PriceFunc) *Executor {
+	e.price = price
+	return e
+}
+
+// LimitPrice set the worst price a child order may be placed at
+func (e *Executor) LimitPrice(limit common.Decimal) *Executor {
+	e.limit = &limit
+	return e
+}
+
+// IOC set whether time sliced children are IMMEDIATE_OR_CANCEL instead of
+// GOOD_TILL_CANCEL. Iceberg children always rest in the book.
+func (e *Executor) IOC(ioc bool) *Executor {
+	e.ioc = ioc
+	return e
+}
+
+// ClientOrderIDPrefix set the prefix of child client order ids
+func (e *Executor) ClientOrderIDPrefix(prefix string) *Executor {
+	e.prefix = prefix
+	return e
+}
+
+// Start work the parent order until it is done, cancelled or failed, or ctx
+// is done which cancels it. doneC is closed once the executor stopped.
+func (e *Executor) Start(ctx context.Context) (doneC chan struct{}, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.state != StatePending {
+		return nil, fmt.Errorf("execution: executor already %s", e.state)
+	}
+	switch {
+	case e.err != nil:
+		return nil, e.err
+	case e.price == nil:
+		return nil, errors.New("execution: price func not set")
+	case !e.quantity.IsPositive():
+		return nil, errors.New("execution: quantity must be positive")
+	case e.side != SideBuy && e.side != SideSell:
+		return nil, fmt.Errorf("execution: invalid side %q", e.side)
+	}
+	e.state = StateRunning
+	e.startedAt = time.Now()
+	doneC = make(chan struct{})
+	go e.run(ctx, doneC)
+	return doneC, nil
+}
+
+// HandleFill apply an execution report of a child order, reports of other orders are ignored
+func (e *Executor) HandleFill(f Fill) {
+	e.mu.Lock()
+	c, ok := e.children[f.ClientOrderID]
+	if !ok || c.done {
+		e.mu.Unlock()
+		return
+	}
+	if f.Quantity.IsPositive() && c.newTrade(f.TradeID) {
+		// a duplicated report without trade id cannot overfill the child
+		quantity := common.MinDecimal(f.Quantity, c.working())
+		c.filled = c.filled.Add(quantity)
+		e.filled = e.filled.Add(quantity)
+		e.notional = e.notional.Add(quantity.Mul(f.Price))
+	}
+	if f.terminal() {
+		c.done = true
+	}
+	e.mu.Unlock()
+	e.wake()
+}
+
+// Pause stop placing child orders and cancel the working ones
+func (e *Executor) Pause(ctx context.Context) error {
+	e.mu.Lock()
+	if e.state != StateRunning {
+		e.mu.Unlock()
+		return fmt.Errorf("execution: cannot pause %s executor", e.state)
+	}
+	e.state = StatePaused
+	working := e.workingLocked()
+	e.mu.Unlock()
+	return e.cancelChildren(ctx, working)
+}
+
+// Resume place child orders again, catching up with the schedule at once
+func (e *Executor) Resume() error {
+	e.mu.Lock()
+	if e.state != StatePaused {
+		e.mu.Unlock()
+		return fmt.Errorf("execution: cannot resume %s executor", e.state)
+	}
+	e.state = StateRunning
+	if e.placed > 0 {
+		e.placed = e.slice - 1
+	}
+	e.mu.Unlock()
+	e.wake()
+	return nil
+}
+
+// Cancel stop the executor and cancel the working child orders
+func (e *Executor) Cancel(ctx context.Context) error {
+	e.mu.Lock()
+	if e.terminalLocked() {
+		e.mu.Unlock()
+		return nil
+	}
+	e.state = StateCancelled
+	working := e.workingLocked()
+	e.mu.Unlock()
+	e.stop()
+	return e.cancelChildren(ctx, working)
+}
+
+// Progress return a snapshot of the executor
+func (e *Executor) Progress() Progress {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	p := Progress{
+		State:     e.state,
+		Quantity:  e.quantity,
+		Filled:    e.filled,
+		Working:   e.outstandingLocked(),
+		Remaining: e.quantity.Sub(e.filled),
+		Children:  len(e.children),
+		Err:       e.err,
+	}
+	if e.filled.IsPositive() {
+		p.AvgPrice = e.notional.Div(e.filled, decimalPlaces, common.RoundHalfEven)
+	}
+	return p
+}
+
+func (e *Executor) wake() {
+	select {
+	case e.wakeC <- struct{}{}:
+	default:
+	}
+}
+
+func (e *Executor) stop() {
+	e.stopOnce.Do(func() { close(e.stopC) })
+}
+
+func (e *Executor) terminalLocked() bool {
+	return e.state == StateDone || e.state == StateCancelled || e.state == StateFailed
+}
+
+func (e *Executor) outstandingLocked() (q common.Decimal) {
+	for _, c := range e.children {
+		if !c.done {
+			q = q.Add(c.working())
+		}
+	}
+	return q
+}
+
+func (e *Executor) workingLocked() (working []*child) {
+	for _, c := range e.children {
+		if !c.done && !c.cancelling && c.orderID != 0 {
+			c.cancelling = true
+			working = append(working, c)
+		}
+	}
+	return working
+}
+
+func (e *Executor) cancellingLocked() bool {
+	for _, c := range e.children {
+		if !c.done && c.cancelling {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Executor) cancelChildren(ctx context.Context, children []*child) (err error) {
+	for _, c := range children {
+		cerr := e.venue.CancelOrder(ctx, e.symbol, c.orderID)
+		if cerr == nil {
+			continue
+		}
+		// still working as far as we know, let the next slice cancel it again
+		e.mu.Lock()
+		c.cancelling = false
+		e.mu.Unlock()
+		if err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+func (e *Executor) run(ctx context.Context, doneC chan struct{}) {
+	defer close(doneC)
+	for {
+		release, cancels, wait, finished := e.next()
+		if err := e.cancelChildren(ctx, cancels); err != nil {
+			// the order may have filled in the meantime, its report settles it
+			e.wake()
+		}
+		if finished {
+			return
+		}
+		if release != nil {
+			if err := e.place(ctx, *release); err != nil {
+				e.fail(err)
+				return
+			}
+			continue
+		}
+		if !e.wait(ctx, wait) {
+			return
+		}
+	}
+}
+
+// wait block until the next slice, a report or a stop, false when stopped
+func (e *Executor) wait(ctx context.Context, wait time.Duration) bool {
+	var timerC <-chan time.Time
+	if wait >= 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		timerC = timer.C
+	}
+	select {
+	case <-ctx.Done():
+		e.Cancel(context.Background())
+		return false
+	case <-e.stopC:
+		return false
+	case <-e.wakeC:
+	case <-timerC:
+	}
+	return true
+}
+
+// next decide what to do now: the quantity to release, the children to
+// cancel and how long to wait for the next slice, negative for no slice
+func (e *Executor) next() (release *common.Decimal, cancels []*child, wait time.Duration, finished bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.terminalLocked() {
+		return nil, nil, 0, true
+	}
+	if e.filled.GreaterThanOrEqual(e.quantity) {
+		e.state = StateDone
+		return nil, nil, 0, true
+	}
+	outstanding := e.outstandingLocked()
+	wait = -1
+	var q common.Decimal
+	if e.display.IsPositive() {
+		if !outstanding.IsZero() || e.state != StateRunning {
+			return nil, nil, wait, false
+		}
+		q = common.MinDecimal(e.quantity.Sub(e.filled), e.display)
+		q = q.Quantize(e.filters.StepSize, common.RoundDown)
+		if !e.tradable(q) {
+			e.state = StateDone
+			return nil, nil, 0, true
+		}
+		return &q, nil, wait, false
+	}
+
+	elapsed := time.Since(e.startedAt)
+	newSlice := false
+	for e.slice < len(e.offsets) && e.offsets[e.slice] <= elapsed {
+		e.slice++
+		newSlice = true
+	}
+	if e.slice < len(e.offsets) {
+		wait = e.offsets[e.slice] - elapsed
+	}
+	if newSlice && !e.ioc && e.state == StateRunning {
+		cancels = e.workingLocked()
+	}
+	if e.state != StateRunning || e.placed >= e.slice || len(cancels) > 0 || e.cancellingLocked() {
+		if e.state == StateRunning && e.slice == len(e.offsets) && e.placed == e.slice && outstanding.IsZero() {
+			e.state = StateDone
+			return nil, cancels, 0, true
+		}
+		return nil, cancels, wait, false
+	}
+	e.placed = e.slice
+	target := e.quantity.Mul(e.weights[e.slice-1]).
+		Div(e.totalWeight, e.quantity.Scale()+decimalPlaces, common.RoundDown).Trim()
+	q = target.Sub(e.filled).Sub(outstanding).Quantize(e.filters.StepSize, common.RoundDown)
+	if !e.tradable(q) {
+		if e.slice == len(e.offsets) && outstanding.IsZero() {
+			e.state = StateDone
+			return nil, nil, 0, true
+		}
+		return nil, nil, wait, false
+	}
+	return &q, nil, wait, false
+}
+
+func (e *Executor) tradable(q common.Decimal) bool {
+	return q.IsPositive() && q.GreaterThanOrEqual(e.filters.MinQuantity)
+}
+
+func (e *Executor) place(ctx context.Context, quantity common.Decimal) error {
+	price, err := e.price(ctx, e.side)
+	if err != nil {
+		return err
+	}
+	if e.side == SideBuy {
+		if e.limit != nil {
+			price = common.MinDecimal(price, *e.limit)
+		}
+		price = price.Quantize(e.filters.TickSize, common.RoundDown)
+	} else {
+		if e.limit != nil {
+			price = common.MaxDecimal(price, *e.limit)
+		}
+		price = price.Quantize(e.filters.TickSize, common.RoundUp)
+	}
+	if !price.IsPositive() {
+		return fmt.Errorf("execution: invalid child price %s", price)
+	}
+	if quantity.Mul(price).LessThan(e.filters.MinNotional) {
+		e.mu.Lock()
+		if e.display.IsPositive() {
+			// an iceberg has no later slice to roll the remainder over to
+			e.state = StateDone
+		}
+		e.mu.Unlock()
+		// too small for now, the remainder rolls over into the next slice
+		return nil
+	}
+
+	e.mu.Lock()
+	if e.state != StateRunning {
+		e.mu.Unlock()
+		return nil
+	}
+	e.seq++
+	c := &child{order: ChildOrder{
+		Symbol:        e.symbol,
+		Side:          e.side,
+		Price:         price,
+		Quantity:      quantity,
+		IOC:           e.ioc && !e.display.IsPositive(),
+		ClientOrderID: fmt.Sprintf("%s-%d", e.prefix, e.seq),
+	}}
+	// register the child first, its reports may arrive before the response
+	e.children[c.order.ClientOrderID] = c
+	e.mu.Unlock()
+
+	orderID, err := e.venue.PlaceOrder(ctx, &c.order)
+	e.mu.Lock()
+	if err != nil {
+		c.done = true
+		e.mu.Unlock()
+		return err
+	}
+	c.orderID = orderID
+	// paused, cancelled or failed while the order was in flight, the
+	// working children were cancelled without it
+	var orphan []*child
+	if e.state != StateRunning && !c.done && !c.cancelling {
+		c.cancelling = true
+		orphan = append(orphan, c)
+	}
+	e.mu.Unlock()
+	if err := e.cancelChildren(context.Background(), orphan); err != nil {
+		// the order may have filled in the meantime, its report settles it
+		e.wake()
+	}
+	return nil
+}
+
+func (e *Executor) fail(err error) {
+	e.mu.Lock()
+	if e.terminalLocked() {
+		e.mu.Unlock()
+		return
+	}
+	e.state = StateFailed
+	e.err = err
+	working := e.workingLocked()
+	e.mu.Unlock()
+	e.cancelChildren(context.Background(), working)
+}
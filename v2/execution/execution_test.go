@@ -0,0 +1,352 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeVenue struct {
+	mu        sync.Mutex
+	e         *Executor
+	fill      bool
+	err       error
+	orders    []*ChildOrder
+	ids       map[int64]*ChildOrder
+	cancelled []int64
+	cancelErr error
+	onPlace   func()
+}
+
+func newFakeVenue(fill bool) *fakeVenue {
+	return &fakeVenue{fill: fill, ids: make(map[int64]*ChildOrder)}
+}
+
+func (v *fakeVenue) PlaceOrder(ctx context.Context, o *ChildOrder) (int64, error) {
+	if v.onPlace != nil {
+		v.onPlace()
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.err != nil {
+		return 0, v.err
+	}
+	cp := *o
+	v.orders = append(v.orders, &cp)
+	id := int64(len(v.orders))
+	v.ids[id] = &cp
+	if v.fill {
+		go v.e.HandleFill(Fill{
+			ClientOrderID: o.ClientOrderID,
+			OrderID:       id,
+			Quantity:      o.Quantity,
+			Price:         o.Price,
+			Status:        "FILLED",
+		})
+	}
+	return id, nil
+}
+
+func (v *fakeVenue) CancelOrder(ctx context.Context, symbol string, orderID int64) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if err := v.cancelErr; err != nil {
+		v.cancelErr = nil
+		return err
+	}
+	v.cancelled = append(v.cancelled, orderID)
+	o := v.ids[orderID]
+	go v.e.HandleFill(Fill{ClientOrderID: o.ClientOrderID, OrderID: orderID, Status: "CANCELED"})
+	return nil
+}
+
+func (v *fakeVenue) snapshot() (orders []*ChildOrder, cancelled []int64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return append(orders, v.orders...), append(cancelled, v.cancelled...)
+}
+
+type executorTestSuite struct {
+	suite.Suite
+}
+
+func TestExecutor(t *testing.T) {
+	suite.Run(t, new(executorTestSuite))
+}
+
+func dec(s string) common.Decimal {
+	return common.MustParseDecimal(s)
+}
+
+func (s *executorTestSuite) wait(doneC chan struct{}) {
+	select {
+	case <-doneC:
+	case <-time.After(2 * time.Second):
+		s.FailNow("executor did not stop")
+	}
+}
+
+func (s *executorTestSuite) quantities(orders []*ChildOrder) []string {
+	res := make([]string, len(orders))
+	for i, o := range orders {
+		res[i] = o.Quantity.String()
+	}
+	return res
+}
+
+func (s *executorTestSuite) TestTWAP() {
+	venue := newFakeVenue(true)
+	e := NewTWAP(venue, "BTCUSDT", SideBuy, dec("3"), 60*time.Millisecond, 3).
+		Filters(Filters{TickSize: dec("0.05"), StepSize: dec("0.1")}).
+		Price(FixedPrice(dec("100.07"))).
+		ClientOrderIDPrefix("twap")
+	venue.e = e
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+	s.wait(doneC)
+
+	orders, _ := venue.snapshot()
+	s.Equal([]string{"1.0", "1.0", "1.0"}, s.quantities(orders))
+	for i, o := range orders {
+		s.Equal("100.05", o.Price.String())
+		s.Equal(SideBuy, o.Side)
+		s.False(o.IOC)
+		s.Equal([]string{"twap-1", "twap-2", "twap-3"}[i], o.ClientOrderID)
+	}
+	p := e.Progress()
+	s.Equal(StateDone, p.State)
+	s.True(p.Filled.Equal(dec("3")))
+	s.True(p.Remaining.IsZero())
+	s.True(p.AvgPrice.Equal(dec("100.05")))
+	s.Equal(3, p.Children)
+}
+
+func (s *executorTestSuite) TestTWAPRollsOverUnfilled() {
+	venue := newFakeVenue(false)
+	e := NewTWAP(venue, "BTCUSDT", SideSell, dec("2"), 40*time.Millisecond, 2).
+		Price(FixedPrice(dec("100"))).
+		LimitPrice(dec("101"))
+	venue.e = e
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+
+	s.Eventually(func() bool {
+		orders, _ := venue.snapshot()
+		return len(orders) == 2
+	}, time.Second, time.Millisecond)
+	orders, cancelled := venue.snapshot()
+	s.Equal([]string{"1", "2"}, s.quantities(orders))
+	s.Equal("101", orders[0].Price.String())
+	s.Equal([]int64{1}, cancelled)
+
+	fill := Fill{ClientOrderID: orders[1].ClientOrderID, TradeID: 7, Quantity: dec("0.5"), Price: dec("101"), Status: "PARTIALLY_FILLED"}
+	e.HandleFill(fill)
+	// a duplicated report of the same trade is ignored
+	e.HandleFill(fill)
+	p := e.Progress()
+	s.Equal(StateRunning, p.State)
+	s.True(p.Working.Equal(dec("1.5")))
+
+	s.Require().NoError(e.Cancel(context.Background()))
+	s.wait(doneC)
+	_, cancelled = venue.snapshot()
+	s.Equal([]int64{1, 2}, cancelled)
+	p = e.Progress()
+	s.Equal(StateCancelled, p.State)
+	s.True(p.Remaining.Equal(dec("1.5")))
+}
+
+func (s *executorTestSuite) TestCancelWhilePlacing() {
+	venue := newFakeVenue(false)
+	e := NewTWAP(venue, "BTCUSDT", SideBuy, dec("1"), time.Hour, 1).Price(FixedPrice(dec("100")))
+	venue.e = e
+	venue.onPlace = func() {
+		venue.onPlace = nil
+		// no order id yet, there is nothing to cancel
+		s.Require().NoError(e.Cancel(context.Background()))
+	}
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+	s.wait(doneC)
+
+	// the order placed meanwhile is cancelled once its id is known
+	s.Eventually(func() bool {
+		_, cancelled := venue.snapshot()
+		return len(cancelled) == 1
+	}, time.Second, time.Millisecond)
+	s.Equal(StateCancelled, e.Progress().State)
+}
+
+func (s *executorTestSuite) TestCancelErrorRetried() {
+	venue := newFakeVenue(false)
+	venue.cancelErr = errors.New("timeout")
+	e := NewTWAP(venue, "BTCUSDT", SideBuy, dec("2"), 40*time.Millisecond, 2).Price(FixedPrice(dec("100")))
+	venue.e = e
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+
+	// the failed cancel of the first child does not hold the second slice back
+	s.Eventually(func() bool {
+		orders, _ := venue.snapshot()
+		return len(orders) == 2
+	}, time.Second, time.Millisecond)
+	orders, cancelled := venue.snapshot()
+	s.Equal([]string{"1", "1"}, s.quantities(orders))
+	s.Empty(cancelled)
+
+	s.Require().NoError(e.Cancel(context.Background()))
+	s.wait(doneC)
+	_, cancelled = venue.snapshot()
+	s.ElementsMatch([]int64{1, 2}, cancelled)
+}
+
+func (s *executorTestSuite) TestFillCappedAtChildQuantity() {
+	venue := newFakeVenue(false)
+	e := NewTWAP(venue, "BTCUSDT", SideBuy, dec("1"), time.Hour, 1).Price(FixedPrice(dec("100")))
+	venue.e = e
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+
+	s.Eventually(func() bool {
+		orders, _ := venue.snapshot()
+		return len(orders) == 1
+	}, time.Second, time.Millisecond)
+	orders, _ := venue.snapshot()
+	fill := Fill{ClientOrderID: orders[0].ClientOrderID, Quantity: dec("0.6"), Price: dec("100"), Status: "PARTIALLY_FILLED"}
+	e.HandleFill(fill)
+	// the reports without trade id cannot be deduplicated, but cannot overfill
+	e.HandleFill(fill)
+	p := e.Progress()
+	s.True(p.Filled.Equal(dec("1")), p.Filled.String())
+	s.True(p.AvgPrice.Equal(dec("100")), p.AvgPrice.String())
+
+	s.Require().NoError(e.Cancel(context.Background()))
+	s.wait(doneC)
+}
+func (s *executorTestSuite) TestVWAPRespectsFilters() {
+	venue := newFakeVenue(true)
+	e := NewVWAP(venue, "BTCUSDT", SideBuy, dec("0.25"), 20*time.Millisecond, []float64{1, 0, 1}).
+		Filters(Filters{StepSize: dec("0.1"), MinQuantity: dec("0.2")}).
+		Price(FixedPrice(dec("100"))).
+		IOC(true)
+	venue.e = e
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+	s.wait(doneC)
+
+	orders, _ := venue.snapshot()
+	s.Equal([]string{"0.2"}, s.quantities(orders))
+	s.True(orders[0].IOC)
+	p := e.Progress()
+	s.Equal(StateDone, p.State)
+	s.True(p.Remaining.Equal(dec("0.05")))
+}
+
+func (s *executorTestSuite) TestIceberg() {
+	venue := newFakeVenue(true)
+	e := NewIceberg(venue, "BTCUSDT", SideBuy, dec("2.5"), dec("1")).
+		Filters(Filters{StepSize: dec("0.1")}).
+		Price(FixedPrice(dec("100"))).
+		IOC(true)
+	venue.e = e
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+	s.wait(doneC)
+
+	orders, _ := venue.snapshot()
+	s.Equal([]string{"1.0", "1.0", "0.5"}, s.quantities(orders))
+	s.False(orders[0].IOC)
+	s.Equal(StateDone, e.Progress().State)
+}
+
+func (s *executorTestSuite) TestPauseResume() {
+	venue := newFakeVenue(false)
+	e := NewIceberg(venue, "BTCUSDT", SideBuy, dec("2"), dec("1")).
+		Price(FixedPrice(dec("100")))
+	venue.e = e
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+	s.Eventually(func() bool {
+		orders, _ := venue.snapshot()
+		return len(orders) == 1
+	}, time.Second, time.Millisecond)
+
+	s.Require().NoError(e.Pause(context.Background()))
+	s.Equal(StatePaused, e.Progress().State)
+	s.Error(e.Pause(context.Background()))
+	s.Eventually(func() bool {
+		return e.Progress().Working.IsZero()
+	}, time.Second, time.Millisecond)
+	orders, cancelled := venue.snapshot()
+	s.Len(orders, 1)
+	s.Equal([]int64{1}, cancelled)
+
+	s.Require().NoError(e.Resume())
+	s.Eventually(func() bool {
+		orders, _ := venue.snapshot()
+		return len(orders) == 2
+	}, time.Second, time.Millisecond)
+	orders, _ = venue.snapshot()
+	e.HandleFill(Fill{ClientOrderID: orders[1].ClientOrderID, Quantity: dec("1"), Price: dec("100"), Status: "FILLED"})
+	s.Eventually(func() bool {
+		orders, _ := venue.snapshot()
+		return len(orders) == 3
+	}, time.Second, time.Millisecond)
+	orders, _ = venue.snapshot()
+	e.HandleFill(Fill{ClientOrderID: orders[2].ClientOrderID, Quantity: dec("1"), Price: dec("100"), Status: "FILLED"})
+	s.wait(doneC)
+	s.Equal(StateDone, e.Progress().State)
+}
+
+func (s *executorTestSuite) TestPlaceError() {
+	venue := newFakeVenue(false)
+	venue.err = errors.New("insufficient balance")
+	e := NewTWAP(venue, "BTCUSDT", SideBuy, dec("1"), time.Second, 2).
+		Price(FixedPrice(dec("100")))
+	venue.e = e
+	doneC, err := e.Start(context.Background())
+	s.Require().NoError(err)
+	s.wait(doneC)
+	p := e.Progress()
+	s.Equal(StateFailed, p.State)
+	s.Equal(venue.err, p.Err)
+}
+
+func (s *executorTestSuite) TestContextCancel() {
+	venue := newFakeVenue(false)
+	e := NewTWAP(venue, "BTCUSDT", SideBuy, dec("1"), time.Hour, 2).
+		Price(FixedPrice(dec("100")))
+	venue.e = e
+	ctx, cancel := context.WithCancel(context.Background())
+	doneC, err := e.Start(ctx)
+	s.Require().NoError(err)
+	s.Eventually(func() bool {
+		orders, _ := venue.snapshot()
+		return len(orders) == 1
+	}, time.Second, time.Millisecond)
+	cancel()
+	s.wait(doneC)
+	_, cancelled := venue.snapshot()
+	s.Equal([]int64{1}, cancelled)
+	s.Equal(StateCancelled, e.Progress().State)
+}
+
+func (s *executorTestSuite) TestStartErrors() {
+	venue := newFakeVenue(false)
+	_, err := NewTWAP(venue, "BTCUSDT", SideBuy, dec("1"), time.Second, 2).Start(context.Background())
+	s.Error(err)
+	_, err = NewVWAP(venue, "BTCUSDT", SideBuy, dec("1"), time.Second, []float64{0, 0}).
+		Price(FixedPrice(dec("1"))).Start(context.Background())
+	s.Error(err)
+	_, err = NewIceberg(venue, "BTCUSDT", SideBuy, dec("1"), dec("0")).
+		Price(FixedPrice(dec("1"))).Start(context.Background())
+	s.Error(err)
+	_, err = NewTWAP(venue, "BTCUSDT", Side("HOLD"), dec("1"), time.Second, 2).
+		Price(FixedPrice(dec("1"))).Start(context.Background())
+	s.Error(err)
+}
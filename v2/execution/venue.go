@@ -0,0 +1,296 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// ChildOrder define a LIMIT child order sliced from a parent order
+type ChildOrder struct {
+	Symbol        string
+	Side          Side
+	Price         common.Decimal
+	Quantity      common.Decimal
+	IOC           bool
+	ClientOrderID string
+}
+
+// Venue place and cancel child orders on a market
+type Venue interface {
+	PlaceOrder(ctx context.Context, o *ChildOrder) (orderID int64, err error)
+	CancelOrder(ctx context.Context, symbol string, orderID int64) error
+}
+
+// SpotVenue place child orders through CreateOrderService
+type SpotVenue struct {
+	c *binance.Client
+}
+
+// NewSpotVenue init a venue trading on the spot account
+func NewSpotVenue(c *binance.Client) *SpotVenue {
+	return &SpotVenue{c: c}
+}
+
+// PlaceOrder place a spot LIMIT order
+func (v *SpotVenue) PlaceOrder(ctx context.Context, o *ChildOrder) (int64, error) {
+	timeInForce := binance.TimeInForceTypeGTC
+	if o.IOC {
+		timeInForce = binance.TimeInForceTypeIOC
+	}
+	res, err := v.c.NewCreateOrderService().Symbol(o.Symbol).
+		Side(binance.SideType(o.Side)).Type(binance.OrderTypeLimit).
+		TimeInForce(timeInForce).QuantityDecimal(o.Quantity).PriceDecimal(o.Price).
+		NewClientOrderID(o.ClientOrderID).Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.OrderID, nil
+}
+
+// CancelOrder cancel a spot order
+func (v *SpotVenue) CancelOrder(ctx context.Context, symbol string, orderID int64) error {
+	_, err := v.c.NewCancelOrderService().Symbol(symbol).OrderID(orderID).Do(ctx)
+	return err
+}
+
+// MarginVenue place child orders through CreateMarginOrderService
+type MarginVenue struct {
+	c          *binance.Client
+	isIsolated bool
+}
+
+// NewMarginVenue init a venue trading on the cross or isolated margin account
+func NewMarginVenue(c *binance.Client, isIsolated bool) *MarginVenue {
+	return &MarginVenue{c: c, isIsolated: isIsolated}
+}
+
+// PlaceOrder place a margin LIMIT order
+func (v *MarginVenue) PlaceOrder(ctx context.Context, o *ChildOrder) (int64, error) {
+	timeInForce := binance.TimeInForceTypeGTC
+	if o.IOC {
+		timeInForce = binance.TimeInForceTypeIOC
+	}
+	res, err := v.c.NewCreateMarginOrderService().Symbol(o.Symbol).IsIsolated(v.isIsolated).
+		Side(binance.SideType(o.Side)).Type(binance.OrderTypeLimit).
+		TimeInForce(timeInForce).QuantityDecimal(o.Quantity).PriceDecimal(o.Price).
+		NewClientOrderID(o.ClientOrderID).Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.OrderID, nil
+}
+
+// CancelOrder cancel a margin order
+func (v *MarginVenue) CancelOrder(ctx context.Context, symbol string, orderID int64) error {
+	_, err := v.c.NewCancelMarginOrderService().Symbol(symbol).IsIsolated(v.isIsolated).
+		OrderID(orderID).Do(ctx)
+	return err
+}
+
+// FuturesVenue place child orders through futures.CreateOrderService
+type FuturesVenue struct {
+	c            *futures.Client
+	positionSide *futures.PositionSideType
+	reduceOnly   bool
+}
+
+// NewFuturesVenue init a venue trading on the USDⓈ-M futures account
+func NewFuturesVenue(c *futures.Client) *FuturesVenue {
+	return &FuturesVenue{c: c}
+}
+
+// PositionSide set the position side of child orders, for hedge mode accounts
+func (v *FuturesVenue) PositionSide(positionSide futures.PositionSideType) *FuturesVenue {
+	v.positionSide = &positionSide
+	return v
+}
+
+// ReduceOnly set whether child orders may only reduce the position
+func (v *FuturesVenue) ReduceOnly(reduceOnly bool) *FuturesVenue {
+	v.reduceOnly = reduceOnly
+	return v
+}
+
+// PlaceOrder place a futures LIMIT order
+func (v *FuturesVenue) PlaceOrder(ctx context.Context, o *ChildOrder) (int64, error) {
+	timeInForce := futures.TimeInForceTypeGTC
+	if o.IOC {
+		timeInForce = futures.TimeInForceTypeIOC
+	}
+	s := v.c.NewCreateOrderService().Symbol(o.Symbol).
+		Side(futures.SideType(o.Side)).Type(futures.OrderTypeLimit).
+		TimeInForce(timeInForce).QuantityDecimal(o.Quantity).PriceDecimal(o.Price).
+		NewClientOrderID(o.ClientOrderID)
+	if v.positionSide != nil {
+		s.PositionSide(*v.positionSide)
+	}
+	if v.reduceOnly {
+		s.ReduceOnly(true)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.OrderID, nil
+}
+
+// CancelOrder cancel a futures order
+func (v *FuturesVenue) CancelOrder(ctx context.Context, symbol string, orderID int64) error {
+	_, err := v.c.NewCancelOrderService().Symbol(symbol).OrderID(orderID).Do(ctx)
+	return err
+}
+
+// Filters define the symbol filters child orders must respect.
+// A zero value disables the matching check.
+type Filters struct {
+	TickSize    common.Decimal
+	StepSize    common.Decimal
+	MinQuantity common.Decimal
+	MinNotional common.Decimal
+}
+
+// SpotFilters read the filters of a spot or margin symbol
+func SpotFilters(s *binance.Symbol) (f Filters, err error) {
+	if p := s.PriceFilter(); p != nil {
+		if f.TickSize, err = parseFilter(s.Symbol, "tickSize", p.TickSize); err != nil {
+			return f, err
+		}
+	}
+	if l := s.LotSizeFilter(); l != nil {
+		if f.StepSize, err = parseFilter(s.Symbol, "stepSize", l.StepSize); err != nil {
+			return f, err
+		}
+		if f.MinQuantity, err = parseFilter(s.Symbol, "minQty", l.MinQuantity); err != nil {
+			return f, err
+		}
+	}
+	if n := s.NotionalFilter(); n != nil {
+		if f.MinNotional, err = parseFilter(s.Symbol, "minNotional", n.MinNotional); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
+}
+
+// FuturesFilters read the filters of a futures symbol
+func FuturesFilters(s *futures.Symbol) (f Filters, err error) {
+	if p := s.PriceFilter(); p != nil {
+		if f.TickSize, err = parseFilter(s.Symbol, "tickSize", p.TickSize); err != nil {
+			return f, err
+		}
+	}
+	if l := s.LotSizeFilter(); l != nil {
+		if f.StepSize, err = parseFilter(s.Symbol, "stepSize", l.StepSize); err != nil {
+			return f, err
+		}
+		if f.MinQuantity, err = parseFilter(s.Symbol, "minQty", l.MinQuantity); err != nil {
+			return f, err
+		}
+	}
+	if n := s.MinNotionalFilter(); n != nil {
+		if f.MinNotional, err = parseFilter(s.Symbol, "notional", n.Notional); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
+}
+
+func parseFilter(symbol, name, value string) (common.Decimal, error) {
+	if value == "" {
+		return common.Decimal{}, nil
+	}
+	d, err := common.ParseDecimal(value)
+	if err != nil {
+		return d, fmt.Errorf("%s %s: %w", symbol, name, err)
+	}
+	return d, nil
+}
+
+// SpotFill convert a spot or margin executionReport event into a fill,
+// ok is false for any other event
+func SpotFill(e *binance.WsUserDataEvent) (f Fill, ok bool, err error) {
+	if e.Event != binance.UserDataEventTypeExecutionReport {
+		return f, false, nil
+	}
+	u := e.OrderUpdate
+	f = Fill{
+		ClientOrderID: u.ClientOrderId,
+		OrderID:       u.Id,
+		TradeID:       u.TradeId,
+		Status:        u.Status,
+	}
+	// a cancel report carries the cancelled id in C and the new one in c
+	if u.OrigCustomOrderId != "" {
+		f.ClientOrderID = u.OrigCustomOrderId
+	}
+	if f.Quantity, err = parseFill(u.LatestVolume); err != nil {
+		return f, false, err
+	}
+	if f.Price, err = parseFill(u.LatestPrice); err != nil {
+		return f, false, err
+	}
+	return f, true, nil
+}
+
+// FuturesFill convert a futures ORDER_TRADE_UPDATE event into a fill,
+// ok is false for any other event
+func FuturesFill(e *futures.WsUserDataEvent) (f Fill, ok bool, err error) {
+	if e.Event != futures.UserDataEventTypeOrderTradeUpdate {
+		return f, false, nil
+	}
+	u := e.OrderTradeUpdate
+	f = Fill{
+		ClientOrderID: u.ClientOrderID,
+		OrderID:       u.ID,
+		TradeID:       u.TradeID,
+		Status:        string(u.Status),
+	}
+	if f.Quantity, err = parseFill(u.LastFilledQty); err != nil {
+		return f, false, err
+	}
+	if f.Price, err = parseFill(u.LastFilledPrice); err != nil {
+		return f, false, err
+	}
+	return f, true, nil
+}
+
+func parseFill(value string) (common.Decimal, error) {
+	if value == "" {
+		return common.Decimal{}, nil
+	}
+	return common.ParseDecimal(value)
+}
+
+// SpotVolumeProfile return the volume of every kline, a VWAP profile built from history
+func SpotVolumeProfile(klines []*binance.Kline) ([]float64, error) {
+	volumes := make([]string, len(klines))
+	for i, k := range klines {
+		volumes[i] = k.Volume
+	}
+	return volumeProfile(volumes)
+}
+
+// FuturesVolumeProfile return the volume of every kline, a VWAP profile built from history
+func FuturesVolumeProfile(klines []*futures.Kline) ([]float64, error) {
+	volumes := make([]string, len(klines))
+	for i, k := range klines {
+		volumes[i] = k.Volume
+	}
+	return volumeProfile(volumes)
+}
+
+func volumeProfile(volumes []string) ([]float64, error) {
+	profile := make([]float64, len(volumes))
+	for i, v := range volumes {
+		d, err := common.ParseDecimal(v)
+		if err != nil {
+			return nil, fmt.Errorf("kline %d volume: %w", i, err)
+		}
+		profile[i] = d.Float64()
+	}
+	return profile, nil
+}
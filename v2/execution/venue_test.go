@@ -0,0 +1,225 @@
+package execution
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/suite"
+)
+
+type venueTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	method string
+	path   string
+	form   url.Values
+}
+
+func TestVenue(t *testing.T) {
+	suite.Run(t, new(venueTestSuite))
+}
+
+func (s *venueTestSuite) SetupTest() {
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.method = r.Method
+		s.path = r.URL.Path
+		s.form, _ = url.ParseQuery(string(body))
+		for k, v := range r.URL.Query() {
+			s.form[k] = v
+		}
+		w.Write([]byte(`{"orderId": 28, "symbol": "BTCUSDT"}`))
+	}))
+}
+
+func (s *venueTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *venueTestSuite) childOrder(ioc bool) *ChildOrder {
+	return &ChildOrder{
+		Symbol:        "BTCUSDT",
+		Side:          SideSell,
+		Price:         dec("30000.10"),
+		Quantity:      dec("0.015"),
+		IOC:           ioc,
+		ClientOrderID: "twap-1",
+	}
+}
+
+func (s *venueTestSuite) assertOrder(timeInForce string) {
+	s.Equal("BTCUSDT", s.form.Get("symbol"))
+	s.Equal("SELL", s.form.Get("side"))
+	s.Equal("LIMIT", s.form.Get("type"))
+	s.Equal(timeInForce, s.form.Get("timeInForce"))
+	s.Equal("0.015", s.form.Get("quantity"))
+	s.Equal("30000.10", s.form.Get("price"))
+	s.Equal("twap-1", s.form.Get("newClientOrderId"))
+}
+
+func (s *venueTestSuite) TestSpotVenue() {
+	c := binance.NewClient("key", "secret")
+	c.BaseURL = s.server.URL
+	v := NewSpotVenue(c)
+
+	orderID, err := v.PlaceOrder(context.Background(), s.childOrder(false))
+	s.Require().NoError(err)
+	s.Equal(int64(28), orderID)
+	s.Equal("/api/v3/order", s.path)
+	s.assertOrder("GTC")
+
+	s.Require().NoError(v.CancelOrder(context.Background(), "BTCUSDT", 28))
+	s.Equal(http.MethodDelete, s.method)
+	s.Equal("28", s.form.Get("orderId"))
+}
+
+func (s *venueTestSuite) TestMarginVenue() {
+	c := binance.NewClient("key", "secret")
+	c.BaseURL = s.server.URL
+	v := NewMarginVenue(c, true)
+
+	_, err := v.PlaceOrder(context.Background(), s.childOrder(true))
+	s.Require().NoError(err)
+	s.Equal("/sapi/v1/margin/order", s.path)
+	s.assertOrder("IOC")
+	s.Equal("TRUE", s.form.Get("isIsolated"))
+}
+
+func (s *venueTestSuite) TestFuturesVenue() {
+	c := futures.NewClient("key", "secret")
+	c.BaseURL = s.server.URL
+	v := NewFuturesVenue(c).PositionSide(futures.PositionSideTypeShort)
+
+	orderID, err := v.PlaceOrder(context.Background(), s.childOrder(true))
+	s.Require().NoError(err)
+	s.Equal(int64(28), orderID)
+	s.Equal("/fapi/v1/order", s.path)
+	s.assertOrder("IOC")
+	s.Equal("SHORT", s.form.Get("positionSide"))
+
+	s.Require().NoError(v.CancelOrder(context.Background(), "BTCUSDT", 28))
+	s.Equal(http.MethodDelete, s.method)
+	s.Equal("28", s.form.Get("orderId"))
+}
+
+func (s *venueTestSuite) TestFilters() {
+	f, err := SpotFilters(&binance.Symbol{
+		Symbol: "BTCUSDT",
+		Filters: []map[string]interface{}{
+			{"filterType": "PRICE_FILTER", "minPrice": "0.01", "maxPrice": "1000000", "tickSize": "0.01"},
+			{"filterType": "LOT_SIZE", "minQty": "0.00001", "maxQty": "9000", "stepSize": "0.00001"},
+			{"filterType": "NOTIONAL", "minNotional": "5", "maxNotional": "9000000"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(Filters{
+		TickSize:    dec("0.01"),
+		StepSize:    dec("0.00001"),
+		MinQuantity: dec("0.00001"),
+		MinNotional: dec("5"),
+	}, f)
+
+	f, err = FuturesFilters(&futures.Symbol{
+		Symbol: "BTCUSDT",
+		Filters: []map[string]interface{}{
+			{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+			{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+			{"filterType": "MIN_NOTIONAL", "notional": "100"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(Filters{
+		TickSize:    dec("0.10"),
+		StepSize:    dec("0.001"),
+		MinQuantity: dec("0.001"),
+		MinNotional: dec("100"),
+	}, f)
+
+	_, err = SpotFilters(&binance.Symbol{
+		Symbol: "BTCUSDT",
+		Filters: []map[string]interface{}{
+			{"filterType": "PRICE_FILTER", "minPrice": "0.01", "maxPrice": "1000000", "tickSize": "tick"},
+		},
+	})
+	s.Error(err)
+}
+
+func (s *venueTestSuite) TestSpotFill() {
+	f, ok, err := SpotFill(&binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeExecutionReport,
+		OrderUpdate: binance.WsOrderUpdate{
+			ClientOrderId: "twap-1",
+			Id:            28,
+			TradeId:       301,
+			Status:        "PARTIALLY_FILLED",
+			LatestVolume:  "0.005",
+			LatestPrice:   "30000.10",
+		},
+	})
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal("twap-1", f.ClientOrderID)
+	s.Equal(int64(28), f.OrderID)
+	s.Equal(int64(301), f.TradeID)
+	s.True(f.Quantity.Equal(dec("0.005")))
+	s.True(f.Price.Equal(dec("30000.1")))
+	s.False(f.terminal())
+
+	f, ok, err = SpotFill(&binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeExecutionReport,
+		OrderUpdate: binance.WsOrderUpdate{
+			ClientOrderId:     "cancel-1",
+			OrigCustomOrderId: "twap-1",
+			Status:            "CANCELED",
+			LatestVolume:      "0.00000000",
+			LatestPrice:       "0.00000000",
+		},
+	})
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal("twap-1", f.ClientOrderID)
+	s.True(f.terminal())
+
+	_, ok, err = SpotFill(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeBalanceUpdate})
+	s.NoError(err)
+	s.False(ok)
+}
+
+func (s *venueTestSuite) TestFuturesFill() {
+	f, ok, err := FuturesFill(&futures.WsUserDataEvent{
+		Event: futures.UserDataEventTypeOrderTradeUpdate,
+		OrderTradeUpdate: futures.WsOrderTradeUpdate{
+			ClientOrderID:   "twap-2",
+			ID:              29,
+			TradeID:         302,
+			Status:          futures.OrderStatusTypeFilled,
+			LastFilledQty:   "0.010",
+			LastFilledPrice: "30001",
+		},
+	})
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal("twap-2", f.ClientOrderID)
+	s.Equal(int64(302), f.TradeID)
+	s.True(f.Quantity.Equal(dec("0.01")))
+	s.True(f.terminal())
+
+	_, ok, err = FuturesFill(&futures.WsUserDataEvent{Event: futures.UserDataEventTypeAccountUpdate})
+	s.NoError(err)
+	s.False(ok)
+}
+
+func (s *venueTestSuite) TestVolumeProfile() {
+	profile, err := SpotVolumeProfile([]*binance.Kline{{Volume: "10.5"}, {Volume: "0"}, {Volume: "2"}})
+	s.Require().NoError(err)
+	s.Equal([]float64{10.5, 0, 2}, profile)
+
+	_, err = FuturesVolumeProfile([]*futures.Kline{{Volume: "x"}})
+	s.Error(err)
+}
@@ -0,0 +1,96 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// BasisService list the basis of a pair and contract type
+type BasisService struct {
+	c            *Client
+	pair         string
+	contractType ContractType
+	period       PeriodType
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *BasisService) Pair(pair string) *BasisService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType
+func (s *BasisService) ContractType(contractType ContractType) *BasisService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period interval
+func (s *BasisService) Period(period PeriodType) *BasisService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *BasisService) Limit(limit int) *BasisService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *BasisService) StartTime(startTime int64) *BasisService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *BasisService) EndTime(endTime int64) *BasisService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *BasisService) Do(ctx context.Context, opts ...RequestOption) (res []*Basis, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/basis",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("contractType", s.contractType)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Basis{}, err
+	}
+	res = make([]*Basis, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Basis{}, err
+	}
+	return res, nil
+}
+
+// Basis define basis info
+type Basis struct {
+	Pair                string       `json:"pair"`
+	ContractType        ContractType `json:"contractType"`
+	IndexPrice          string       `json:"indexPrice"`
+	FuturesPrice        string       `json:"futuresPrice"`
+	Basis               string       `json:"basis"`
+	BasisRate           string       `json:"basisRate"`
+	AnnualizedBasisRate string       `json:"annualizedBasisRate"`
+	Timestamp           int64        `json:"timestamp"`
+}
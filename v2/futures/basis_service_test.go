@@ -0,0 +1,57 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type basisServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBasisService(t *testing.T) {
+	suite.Run(t, new(basisServiceTestSuite))
+}
+
+func (s *basisServiceTestSuite) TestBasis() {
+	data := []byte(`[
+		{
+			"indexPrice": "34400.15945055",
+			"contractType": "PERPETUAL",
+			"basisRate": "0.0004",
+			"futuresPrice": "34414.10",
+			"annualizedBasisRate": "",
+			"basis": "13.94054945",
+			"pair": "BTCUSDT",
+			"timestamp": 1698742800000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         "BTCUSDT",
+			"contractType": ContractTypePerpetual,
+			"period":       Period1d,
+			"limit":        1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewBasisService().Pair("BTCUSDT").ContractType(ContractTypePerpetual).
+		Period(Period1d).Limit(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Basis{
+		{
+			Pair:         "BTCUSDT",
+			ContractType: ContractTypePerpetual,
+			IndexPrice:   "34400.15945055",
+			FuturesPrice: "34414.10",
+			Basis:        "13.94054945",
+			BasisRate:    "0.0004",
+			Timestamp:    1698742800000,
+		},
+	}, res)
+}
@@ -0,0 +1,456 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// BracketStateType define the state of a bracket order
+type BracketStateType string
+
+// Global enums
+const (
+	BracketStatePending      BracketStateType = "PENDING"
+	BracketStateEntryWorking BracketStateType = "ENTRY_WORKING"
+	BracketStateArming       BracketStateType = "ARMING"
+	BracketStateArmed        BracketStateType = "ARMED"
+	BracketStateClosed       BracketStateType = "CLOSED"
+	BracketStateCancelled    BracketStateType = "CANCELLED"
+	BracketStateFailed       BracketStateType = "FAILED"
+
+	bracketEntrySuffix      = "-e"
+	bracketTakeProfitSuffix = "-tp"
+	bracketStopLossSuffix   = "-sl"
+)
+
+// BracketOrder open a position with an entry order and protect it with a
+// TAKE_PROFIT_MARKET and a STOP_MARKET order once the entry is filled.
+//
+// Feed the user data stream to HandleUserData: the exits are armed through
+// CreateBatchOrdersService for the filled quantity when the entry is filled,
+// or when it is cancelled or expires after a partial fill, and the sibling of
+// the exit that fills first is cancelled. In hedge mode the exits use the
+// positionSide of the entry, otherwise they are reduceOnly.
+type BracketOrder struct {
+	c            *Client
+	symbol       string
+	side         SideType
+	positionSide *PositionSideType
+	orderType    OrderType
+	timeInForce  *TimeInForceType
+	quantity     string
+	price        *string
+	takeProfit   *string
+	stopLoss     *string
+	workingType  *WorkingType
+	priceProtect *bool
+	prefix       string
+	errHandler   ErrHandler
+
+	mu     sync.Mutex
+	wg     sync.WaitGroup
+	status BracketOrderStatus
+}
+
+// BracketOrderStatus define a snapshot of a bracket order
+type BracketOrderStatus struct {
+	State             BracketStateType
+	EntryOrderID      int64
+	Filled            common.Decimal
+	TakeProfitOrderID int64
+	StopLossOrderID   int64
+	// ClosedBy is TAKE_PROFIT_MARKET or STOP_MARKET once an exit filled
+	ClosedBy OrderType
+	Err      error
+}
+
+// Symbol set symbol
+func (b *BracketOrder) Symbol(symbol string) *BracketOrder {
+	b.symbol = symbol
+	return b
+}
+
+// Side set the side of the entry order
+func (b *BracketOrder) Side(side SideType) *BracketOrder {
+	b.side = side
+	return b
+}
+
+// PositionSide set positionSide, for hedge mode accounts
+func (b *BracketOrder) PositionSide(positionSide PositionSideType) *BracketOrder {
+	b.positionSide = &positionSide
+	return b
+}
+
+// Type set the type of the entry order, LIMIT by default
+func (b *BracketOrder) Type(orderType OrderType) *BracketOrder {
+	b.orderType = orderType
+	return b
+}
+
+// TimeInForce set the timeInForce of the entry order
+func (b *BracketOrder) TimeInForce(timeInForce TimeInForceType) *BracketOrder {
+	b.timeInForce = &timeInForce
+	return b
+}
+
+// Quantity set quantity
+func (b *BracketOrder) Quantity(quantity string) *BracketOrder {
+	b.quantity = quantity
+	return b
+}
+
+// QuantityDecimal set quantity from a decimal
+func (b *BracketOrder) QuantityDecimal(d common.Decimal) *BracketOrder {
+	return b.Quantity(d.String())
+}
+
+// Price set the price of the entry order
+func (b *BracketOrder) Price(price string) *BracketOrder {
+	b.price = &price
+	return b
+}
+
+// PriceDecimal set the price of the entry order from a decimal
+func (b *BracketOrder) PriceDecimal(d common.Decimal) *BracketOrder {
+	return b.Price(d.String())
+}
+
+// TakeProfitPrice set the stopPrice of the TAKE_PROFIT_MARKET exit
+func (b *BracketOrder) TakeProfitPrice(price string) *BracketOrder {
+	b.takeProfit = &price
+	return b
+}
+
+// TakeProfitPriceDecimal set the stopPrice of the TAKE_PROFIT_MARKET exit from a decimal
+func (b *BracketOrder) TakeProfitPriceDecimal(d common.Decimal) *BracketOrder {
+	return b.TakeProfitPrice(d.String())
+}
+
+// StopLossPrice set the stopPrice of the STOP_MARKET exit
+func (b *BracketOrder) StopLossPrice(price string) *BracketOrder {
+	b.stopLoss = &price
+	return b
+}
+
+// StopLossPriceDecimal set the stopPrice of the STOP_MARKET exit from a decimal
+func (b *BracketOrder) StopLossPriceDecimal(d common.Decimal) *BracketOrder {
+	return b.StopLossPrice(d.String())
+}
+
+// WorkingType set the workingType of the exits
+func (b *BracketOrder) WorkingType(workingType WorkingType) *BracketOrder {
+	b.workingType = &workingType
+	return b
+}
+
+// PriceProtect set the priceProtect of the exits
+func (b *BracketOrder) PriceProtect(priceProtect bool) *BracketOrder {
+	b.priceProtect = &priceProtect
+	return b
+}
+
+// ClientOrderIDPrefix set the prefix of the client order ids, suffixed
+// with -e for the entry, -tp and -sl for the exits
+func (b *BracketOrder) ClientOrderIDPrefix(prefix string) *BracketOrder {
+	b.prefix = prefix
+	return b
+}
+
+// OnError set the handler of errors met while handling stream events
+func (b *BracketOrder) OnError(errHandler ErrHandler) *BracketOrder {
+	b.errHandler = errHandler
+	return b
+}
+
+// EntryClientOrderID return the client order id of the entry order
+func (b *BracketOrder) EntryClientOrderID() string {
+	return b.prefix + bracketEntrySuffix
+}
+
+// TakeProfitClientOrderID return the client order id of the take-profit exit
+func (b *BracketOrder) TakeProfitClientOrderID() string {
+	return b.prefix + bracketTakeProfitSuffix
+}
+
+// StopLossClientOrderID return the client order id of the stop-loss exit
+func (b *BracketOrder) StopLossClientOrderID() string {
+	return b.prefix + bracketStopLossSuffix
+}
+
+// Status return a snapshot of the bracket order
+func (b *BracketOrder) Status() BracketOrderStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.status
+}
+
+// Wait block until the requests triggered by stream events are done
+func (b *BracketOrder) Wait() {
+	b.wg.Wait()
+}
+
+func (b *BracketOrder) hedged() bool {
+	return b.positionSide != nil && *b.positionSide != PositionSideTypeBoth
+}
+
+func (b *BracketOrder) exitSide() SideType {
+	if b.side == SideTypeBuy {
+		return SideTypeSell
+	}
+	return SideTypeBuy
+}
+
+func (b *BracketOrder) handleErr(err error) {
+	if err != nil && b.errHandler != nil {
+		b.errHandler(err)
+	}
+}
+
+// Submit place the entry order
+func (b *BracketOrder) Submit(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	switch {
+	case b.symbol == "":
+		return nil, errors.New("bracket: symbol not set")
+	case b.side != SideTypeBuy && b.side != SideTypeSell:
+		return nil, fmt.Errorf("bracket: invalid side %q", b.side)
+	case b.quantity == "":
+		return nil, errors.New("bracket: quantity not set")
+	case b.takeProfit == nil && b.stopLoss == nil:
+		return nil, errors.New("bracket: no take-profit or stop-loss price")
+	}
+	b.mu.Lock()
+	if b.status.State != BracketStatePending {
+		b.mu.Unlock()
+		return nil, fmt.Errorf("bracket: already %s", b.status.State)
+	}
+	// the entry reports may arrive before the response
+	b.status.State = BracketStateEntryWorking
+	b.mu.Unlock()
+
+	s := b.c.NewCreateOrderService().Symbol(b.symbol).Side(b.side).Type(b.orderType).
+		Quantity(b.quantity).NewClientOrderID(b.EntryClientOrderID())
+	if b.positionSide != nil {
+		s.PositionSide(*b.positionSide)
+	}
+	if b.timeInForce != nil {
+		s.TimeInForce(*b.timeInForce)
+	} else if b.orderType == OrderTypeLimit {
+		s.TimeInForce(TimeInForceTypeGTC)
+	}
+	if b.price != nil {
+		s.Price(*b.price)
+	}
+	res, err = s.Do(ctx, opts...)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil {
+		b.status.State = BracketStateFailed
+		b.status.Err = err
+		return nil, err
+	}
+	b.status.EntryOrderID = res.OrderID
+	return res, nil
+}
+
+// Cancel cancel the entry order if it is still working and the exits if
+// they are armed
+func (b *BracketOrder) Cancel(ctx context.Context, opts ...RequestOption) error {
+	b.mu.Lock()
+	state := b.status.State
+	var clientOrderIDs []string
+	switch state {
+	case BracketStateEntryWorking:
+		clientOrderIDs = []string{b.EntryClientOrderID()}
+	case BracketStateArmed:
+		if b.status.TakeProfitOrderID != 0 {
+			clientOrderIDs = append(clientOrderIDs, b.TakeProfitClientOrderID())
+		}
+		if b.status.StopLossOrderID != 0 {
+			clientOrderIDs = append(clientOrderIDs, b.StopLossClientOrderID())
+		}
+	default:
+		b.mu.Unlock()
+		return fmt.Errorf("bracket: cannot cancel %s bracket", state)
+	}
+	b.status.State = BracketStateCancelled
+	b.mu.Unlock()
+
+	var err error
+	for _, id := range clientOrderIDs {
+		_, cerr := b.c.NewCancelOrderService().Symbol(b.symbol).OrigClientOrderID(id).Do(ctx, opts...)
+		if cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// HandleUserData apply the ORDER_TRADE_UPDATE events of the bracket orders
+func (b *BracketOrder) HandleUserData(event *WsUserDataEvent) {
+	if event.Event == UserDataEventTypeOrderTradeUpdate {
+		b.HandleOrderTradeUpdate(event.OrderTradeUpdate)
+	}
+}
+
+// HandleOrderTradeUpdate apply an order update, updates of other orders are ignored
+func (b *BracketOrder) HandleOrderTradeUpdate(u WsOrderTradeUpdate) {
+	switch u.ClientOrderID {
+	case b.EntryClientOrderID():
+		b.handleEntry(u)
+	case b.TakeProfitClientOrderID():
+		b.handleExit(u, OrderTypeTakeProfitMarket)
+	case b.StopLossClientOrderID():
+		b.handleExit(u, OrderTypeStopMarket)
+	}
+}
+
+func (b *BracketOrder) handleEntry(u WsOrderTradeUpdate) {
+	// the accumulated quantity makes a replayed update harmless, the last
+	// filled one is only summed for updates without it
+	cumulative := u.AccumulatedFilledQty != ""
+	qtyStr := u.AccumulatedFilledQty
+	if !cumulative {
+		qtyStr = u.LastFilledQty
+	}
+	var qty common.Decimal
+	if qtyStr != "" {
+		var err error
+		if qty, err = common.ParseDecimal(qtyStr); err != nil {
+			b.handleErr(err)
+			return
+		}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if cumulative {
+		b.status.Filled = common.MaxDecimal(b.status.Filled, qty)
+	} else {
+		b.status.Filled = b.status.Filled.Add(qty)
+	}
+	switch u.Status {
+	case OrderStatusTypeFilled, OrderStatusTypeCanceled, OrderStatusTypeExpired, OrderStatusTypeRejected:
+	default:
+		return
+	}
+	if b.status.State != BracketStateEntryWorking && b.status.State != BracketStateCancelled {
+		return
+	}
+	// protect whatever was filled, even when the entry was cancelled by Cancel
+	if !b.status.Filled.IsPositive() {
+		if b.status.State == BracketStateEntryWorking {
+			b.status.State = BracketStateCancelled
+		}
+		return
+	}
+	b.status.State = BracketStateArming
+	quantity := b.status.Filled.Trim().String()
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		b.handleErr(b.arm(context.Background(), quantity))
+	}()
+}
+
+// arm place the exits of the filled quantity in a single batch
+func (b *BracketOrder) arm(ctx context.Context, quantity string) error {
+	var exits []*CreateOrderService
+	var types []OrderType
+	newExit := func(orderType OrderType, stopPrice, clientOrderID string) *CreateOrderService {
+		s := b.c.NewCreateOrderService().Symbol(b.symbol).Side(b.exitSide()).Type(orderType).
+			StopPrice(stopPrice).Quantity(quantity).NewClientOrderID(clientOrderID)
+		if b.hedged() {
+			s.PositionSide(*b.positionSide)
+		} else {
+			s.ReduceOnly(true)
+		}
+		if b.workingType != nil {
+			s.WorkingType(*b.workingType)
+		}
+		if b.priceProtect != nil {
+			s.PriceProtect(*b.priceProtect)
+		}
+		return s
+	}
+	if b.takeProfit != nil {
+		exits = append(exits, newExit(OrderTypeTakeProfitMarket, *b.takeProfit, b.TakeProfitClientOrderID()))
+		types = append(types, OrderTypeTakeProfitMarket)
+	}
+	if b.stopLoss != nil {
+		exits = append(exits, newExit(OrderTypeStopMarket, *b.stopLoss, b.StopLossClientOrderID()))
+		types = append(types, OrderTypeStopMarket)
+	}
+	res, err := b.c.NewCreateBatchOrdersService().OrderList(exits).Do(ctx)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil {
+		b.status.State = BracketStateFailed
+		b.status.Err = err
+		return err
+	}
+	for i, result := range res.Results {
+		if result.Error != nil {
+			// keep the exit that made it, a half protected position beats none
+			err = fmt.Errorf("bracket: %s exit: %w", types[i], result.Error)
+			b.status.Err = err
+			continue
+		}
+		if types[i] == OrderTypeTakeProfitMarket {
+			b.status.TakeProfitOrderID = result.Order.OrderID
+		} else {
+			b.status.StopLossOrderID = result.Order.OrderID
+		}
+	}
+	switch b.status.State {
+	case BracketStateArming:
+		b.status.State = BracketStateArmed
+		if b.status.TakeProfitOrderID == 0 && b.status.StopLossOrderID == 0 {
+			b.status.State = BracketStateFailed
+		}
+	case BracketStateClosed:
+		// an exit filled before the response, its sibling is known only now
+		b.cancelSibling(b.status.ClosedBy)
+	}
+	return err
+}
+
+func (b *BracketOrder) handleExit(u WsOrderTradeUpdate, orderType OrderType) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if u.Status != OrderStatusTypeFilled || b.status.State == BracketStateClosed {
+		return
+	}
+	b.status.State = BracketStateClosed
+	b.status.ClosedBy = orderType
+	b.cancelSibling(orderType)
+}
+
+// cancelSibling cancel the exit other than the filled one, if it was placed
+func (b *BracketOrder) cancelSibling(filled OrderType) {
+	sibling, clientOrderID := b.status.StopLossOrderID, b.StopLossClientOrderID()
+	if filled == OrderTypeStopMarket {
+		sibling, clientOrderID = b.status.TakeProfitOrderID, b.TakeProfitClientOrderID()
+	}
+	if sibling == 0 {
+		return
+	}
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		_, err := b.c.NewCancelOrderService().Symbol(b.symbol).
+			OrigClientOrderID(clientOrderID).Do(context.Background())
+		b.handleErr(err)
+	}()
+}
+
+func newBracketClientOrderIDPrefix() string {
+	return "b" + strconv.FormatInt(time.Now().UnixNano(), 36)
+}
@@ -0,0 +1,265 @@
+package futures
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type bracketOrderServiceTestSuite struct {
+	baseTestSuite
+	mu    sync.Mutex
+	forms []*request
+	errs  []error
+}
+
+func TestBracketOrderService(t *testing.T) {
+	suite.Run(t, new(bracketOrderServiceTestSuite))
+}
+
+func (s *bracketOrderServiceTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.client.Client.do = s.client.do
+	s.forms = nil
+	s.errs = nil
+	s.assertReq(func(r *request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.forms = append(s.forms, r)
+	})
+}
+
+func (s *bracketOrderServiceTestSuite) newBracket() *BracketOrder {
+	return s.client.NewBracketOrder().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Quantity("2").Price("100").TakeProfitPrice("110").StopLossPrice("95").
+		ClientOrderIDPrefix("b1").OnError(func(err error) { s.errs = append(s.errs, err) })
+}
+
+func (s *bracketOrderServiceTestSuite) submit(b *BracketOrder) {
+	s.mockDoOnce([]byte(`{"orderId": 1, "clientOrderId": "b1-e", "status": "NEW"}`), nil)
+	res, err := b.Submit(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1), res.OrderID)
+}
+
+func (s *bracketOrderServiceTestSuite) update(b *BracketOrder, clientOrderID string, status OrderStatusType, lastQty string) {
+	b.HandleUserData(&WsUserDataEvent{
+		Event: UserDataEventTypeOrderTradeUpdate,
+		OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol:        "BTCUSDT",
+			ClientOrderID: clientOrderID,
+			Status:        status,
+			LastFilledQty: lastQty,
+		},
+	})
+	b.Wait()
+}
+
+func (s *bracketOrderServiceTestSuite) batchOrders(i int) []map[string]interface{} {
+	var orders []map[string]interface{}
+	s.r().NoError(json.Unmarshal([]byte(s.forms[i].form.Get("batchOrders")), &orders))
+	return orders
+}
+
+func (s *bracketOrderServiceTestSuite) TestSubmit() {
+	b := s.newBracket()
+	s.submit(b)
+	e := newSignedRequest().setFormParams(params{
+		"symbol":           "BTCUSDT",
+		"side":             SideTypeBuy,
+		"type":             OrderTypeLimit,
+		"timeInForce":      TimeInForceTypeGTC,
+		"quantity":         "2",
+		"price":            "100",
+		"newClientOrderId": "b1-e",
+		"newOrderRespType": "",
+	})
+	s.assertRequestEqual(e, s.forms[0])
+	st := b.Status()
+	s.r().Equal(BracketStateEntryWorking, st.State)
+	s.r().Equal(int64(1), st.EntryOrderID)
+
+	_, err := b.Submit(newContext())
+	s.r().Error(err)
+}
+
+func (s *bracketOrderServiceTestSuite) TestArmOnFill() {
+	b := s.newBracket()
+	s.submit(b)
+	s.mockDoOnce([]byte(`[{"orderId": 2, "clientOrderId": "b1-tp"}, {"orderId": 3, "clientOrderId": "b1-sl"}]`), nil)
+	s.update(b, "b1-e", OrderStatusTypePartiallyFilled, "0.5")
+	s.r().Len(s.forms, 1)
+	s.update(b, "b1-e", OrderStatusTypeFilled, "1.5")
+
+	s.r().Len(s.forms, 2)
+	s.r().Equal("/fapi/v1/batchOrders", s.calledRequest(1).URL.Path)
+	orders := s.batchOrders(1)
+	s.r().Len(orders, 2)
+	s.r().Equal(map[string]interface{}{
+		"symbol":           "BTCUSDT",
+		"side":             "SELL",
+		"type":             "TAKE_PROFIT_MARKET",
+		"quantity":         "2",
+		"stopPrice":        "110",
+		"reduceOnly":       true,
+		"newClientOrderId": "b1-tp",
+		"newOrderRespType": "",
+	}, orders[0])
+	s.r().Equal("STOP_MARKET", orders[1]["type"])
+	s.r().Equal("95", orders[1]["stopPrice"])
+	s.r().Equal("b1-sl", orders[1]["newClientOrderId"])
+
+	st := b.Status()
+	s.r().Equal(BracketStateArmed, st.State)
+	s.r().True(st.Filled.Equal(common.MustParseDecimal("2")))
+	s.r().Equal(int64(2), st.TakeProfitOrderID)
+	s.r().Equal(int64(3), st.StopLossOrderID)
+	s.r().Empty(s.errs)
+}
+
+func (s *bracketOrderServiceTestSuite) TestArmOnReplayedFill() {
+	b := s.newBracket()
+	s.submit(b)
+	s.mockDoOnce([]byte(`[{"orderId": 2}, {"orderId": 3}]`), nil)
+	fill := func(status OrderStatusType, lastQty, accumulatedQty string) {
+		b.HandleOrderTradeUpdate(WsOrderTradeUpdate{
+			Symbol:               "BTCUSDT",
+			ClientOrderID:        "b1-e",
+			Status:               status,
+			LastFilledQty:        lastQty,
+			AccumulatedFilledQty: accumulatedQty,
+		})
+		b.Wait()
+	}
+	fill(OrderStatusTypePartiallyFilled, "0.5", "0.5")
+	// replayed after a reconnect
+	fill(OrderStatusTypePartiallyFilled, "0.5", "0.5")
+	fill(OrderStatusTypeFilled, "1.5", "2")
+
+	s.r().Len(s.forms, 2)
+	s.r().Equal("2", s.batchOrders(1)[0]["quantity"])
+	s.r().True(b.Status().Filled.Equal(common.MustParseDecimal("2")))
+}
+
+func (s *bracketOrderServiceTestSuite) TestHedgeMode() {
+	b := s.newBracket().PositionSide(PositionSideTypeLong).StopLossPrice("95").WorkingType(WorkingTypeMarkPrice)
+	s.submit(b)
+	s.r().Equal("LONG", s.forms[0].form.Get("positionSide"))
+	s.mockDoOnce([]byte(`[{"orderId": 2}, {"orderId": 3}]`), nil)
+	s.update(b, "b1-e", OrderStatusTypeFilled, "2")
+
+	for _, o := range s.batchOrders(1) {
+		s.r().Equal("LONG", o["positionSide"])
+		s.r().Equal("MARK_PRICE", o["workingType"])
+		s.r().NotContains(o, "reduceOnly")
+	}
+}
+
+func (s *bracketOrderServiceTestSuite) TestPartialFillThenCancel() {
+	b := s.newBracket()
+	s.submit(b)
+	s.update(b, "b1-e", OrderStatusTypePartiallyFilled, "0.4")
+
+	s.mockDoOnce([]byte(`{"orderId": 1, "status": "CANCELED"}`), nil)
+	s.r().NoError(b.Cancel(newContext()))
+	s.r().Equal("b1-e", s.forms[1].form.Get("origClientOrderId"))
+	s.r().Equal(BracketStateCancelled, b.Status().State)
+
+	// the filled part is still protected
+	s.mockDoOnce([]byte(`[{"orderId": 2}, {"orderId": 3}]`), nil)
+	s.update(b, "b1-e", OrderStatusTypeCanceled, "")
+	orders := s.batchOrders(2)
+	s.r().Equal("0.4", orders[0]["quantity"])
+	s.r().Equal("0.4", orders[1]["quantity"])
+	s.r().Equal(BracketStateArmed, b.Status().State)
+}
+
+func (s *bracketOrderServiceTestSuite) TestCancelledWithoutFill() {
+	b := s.newBracket()
+	s.submit(b)
+	s.update(b, "b1-e", OrderStatusTypeExpired, "")
+	s.r().Len(s.forms, 1)
+	s.r().Equal(BracketStateCancelled, b.Status().State)
+	s.r().Error(b.Cancel(newContext()))
+}
+
+func (s *bracketOrderServiceTestSuite) TestTakeProfitCancelsStopLoss() {
+	b := s.newBracket()
+	s.submit(b)
+	s.mockDoOnce([]byte(`[{"orderId": 2}, {"orderId": 3}]`), nil)
+	s.update(b, "b1-e", OrderStatusTypeFilled, "2")
+
+	s.mockDoOnce([]byte(`{"orderId": 3, "status": "CANCELED"}`), nil)
+	s.update(b, "b1-tp", OrderStatusTypeFilled, "2")
+	s.r().Len(s.forms, 3)
+	s.r().Equal("/fapi/v1/order", s.calledRequest(2).URL.Path)
+	s.r().Equal("b1-sl", s.forms[2].form.Get("origClientOrderId"))
+	st := b.Status()
+	s.r().Equal(BracketStateClosed, st.State)
+	s.r().Equal(OrderTypeTakeProfitMarket, st.ClosedBy)
+
+	// the cancelled sibling reports are ignored
+	s.update(b, "b1-sl", OrderStatusTypeCanceled, "")
+	s.r().Len(s.forms, 3)
+	s.r().Equal(BracketStateClosed, b.Status().State)
+}
+
+func (s *bracketOrderServiceTestSuite) TestCancelArmed() {
+	b := s.newBracket()
+	s.submit(b)
+	s.mockDoOnce([]byte(`[{"orderId": 2}, {"orderId": 3}]`), nil)
+	s.update(b, "b1-e", OrderStatusTypeFilled, "2")
+
+	s.mockDoOnce([]byte(`{"orderId": 2}`), nil)
+	s.mockDoOnce([]byte(`{"orderId": 3}`), nil)
+	s.r().NoError(b.Cancel(newContext()))
+	s.r().Equal("b1-tp", s.forms[2].form.Get("origClientOrderId"))
+	s.r().Equal("b1-sl", s.forms[3].form.Get("origClientOrderId"))
+	s.r().Equal(BracketStateCancelled, b.Status().State)
+}
+
+func (s *bracketOrderServiceTestSuite) TestExitRejected() {
+	b := s.newBracket()
+	s.submit(b)
+	s.mockDoOnce([]byte(`[{"orderId": 2}, {"code": -2021, "msg": "Order would immediately trigger."}]`), nil)
+	s.update(b, "b1-e", OrderStatusTypeFilled, "2")
+
+	st := b.Status()
+	s.r().Equal(BracketStateArmed, st.State)
+	s.r().Equal(int64(2), st.TakeProfitOrderID)
+	s.r().Zero(st.StopLossOrderID)
+	s.r().Len(s.errs, 1)
+	s.r().Contains(s.errs[0].Error(), "STOP_MARKET")
+
+	// without a stop-loss there is no sibling to cancel
+	s.update(b, "b1-tp", OrderStatusTypeFilled, "2")
+	s.r().Len(s.forms, 2)
+}
+
+func (s *bracketOrderServiceTestSuite) TestEntryRejected() {
+	b := s.newBracket()
+	s.mockDoOnce([]byte(`{"code": -2019, "msg": "Margin is insufficient."}`), nil, http.StatusBadRequest)
+	_, err := b.Submit(newContext())
+	s.r().Error(err)
+	st := b.Status()
+	s.r().Equal(BracketStateFailed, st.State)
+	s.r().Error(st.Err)
+}
+
+func (s *bracketOrderServiceTestSuite) TestValidate() {
+	for _, b := range []*BracketOrder{
+		s.newBracket().Symbol(""),
+		s.newBracket().Side("HOLD"),
+		s.newBracket().Quantity(""),
+		s.client.NewBracketOrder().Symbol("BTCUSDT").Side(SideTypeBuy).Quantity("1").Price("100"),
+	} {
+		_, err := b.Submit(newContext())
+		s.r().Error(err)
+		s.r().Equal(BracketStatePending, b.Status().State)
+	}
+	s.r().Empty(s.forms)
+}
@@ -71,6 +71,16 @@ type UserDataEventReasonType string
 // ForceOrderCloseType define reason type for force order
 type ForceOrderCloseType string
 
+// STPModeType define the self-trade prevention mode
+type STPModeType string
+
+// PriceMatchType define the price match mode of an order
+type PriceMatchType string
+
+// PeriodType define the period of the futures/data statistics endpoints, an
+// alias of string so that the Period setters keep accepting plain strings
+type PeriodType = string
+
 // Endpoints
 const (
 	baseApiMainUrl    = "https://fapi.binance.com"
@@ -98,6 +108,7 @@ const (
 	TimeInForceTypeIOC TimeInForceType = "IOC" // Immediate or Cancel
 	TimeInForceTypeFOK TimeInForceType = "FOK" // Fill or Kill
 	TimeInForceTypeGTX TimeInForceType = "GTX" // Good Till Crossing (Post Only)
+	TimeInForceTypeGTD TimeInForceType = "GTD" // Good Till Date
 
 	NewOrderRespTypeACK    NewOrderRespType = "ACK"
 	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
@@ -118,6 +129,22 @@ const (
 	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
 	OrderStatusTypeNewInsurance    OrderStatusType = "NEW_INSURANCE"
 	OrderStatusTypeNewADL          OrderStatusType = "NEW_ADL"
+	OrderStatusTypeExpiredInMatch  OrderStatusType = "EXPIRED_IN_MATCH" // expired by self-trade prevention
+
+	STPModeNone        STPModeType = "NONE"
+	STPModeExpireTaker STPModeType = "EXPIRE_TAKER"
+	STPModeExpireMaker STPModeType = "EXPIRE_MAKER"
+	STPModeExpireBoth  STPModeType = "EXPIRE_BOTH"
+
+	PriceMatchNone       PriceMatchType = "NONE"
+	PriceMatchOpponent   PriceMatchType = "OPPONENT"    // best price on the opposite side
+	PriceMatchOpponent5  PriceMatchType = "OPPONENT_5"  // 5th best price on the opposite side
+	PriceMatchOpponent10 PriceMatchType = "OPPONENT_10" // 10th best price on the opposite side
+	PriceMatchOpponent20 PriceMatchType = "OPPONENT_20" // 20th best price on the opposite side
+	PriceMatchQueue      PriceMatchType = "QUEUE"       // best price on the same side
+	PriceMatchQueue5     PriceMatchType = "QUEUE_5"     // 5th best price on the same side
+	PriceMatchQueue10    PriceMatchType = "QUEUE_10"    // 10th best price on the same side
+	PriceMatchQueue20    PriceMatchType = "QUEUE_20"    // 20th best price on the same side
 
 	SymbolTypeFuture SymbolType = "FUTURE"
 
@@ -147,7 +174,19 @@ const (
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
 
-	ContractTypePerpetual ContractType = "PERPETUAL"
+	ContractTypePerpetual      ContractType = "PERPETUAL"
+	ContractTypeCurrentQuarter ContractType = "CURRENT_QUARTER"
+	ContractTypeNextQuarter    ContractType = "NEXT_QUARTER"
+
+	Period5m  PeriodType = "5m"
+	Period15m PeriodType = "15m"
+	Period30m PeriodType = "30m"
+	Period1h  PeriodType = "1h"
+	Period2h  PeriodType = "2h"
+	Period4h  PeriodType = "4h"
+	Period6h  PeriodType = "6h"
+	Period12h PeriodType = "12h"
+	Period1d  PeriodType = "1d"
 
 	UserDataEventTypeListenKeyExpired    UserDataEventType = "listenKeyExpired"
 	UserDataEventTypeMarginCall          UserDataEventType = "MARGIN_CALL"
@@ -247,6 +286,8 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
+
+	apiErrorHooks common.APIErrorHooks
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -355,6 +396,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if e != nil {
 			c.debug("failed to unmarshal json: %s", e)
 		}
+		c.apiErrorHooks.Call(apiErr)
 		return nil, &http.Header{}, apiErr
 	}
 	return data, &res.Header, nil
@@ -441,6 +483,21 @@ func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
 	return &CreateBatchOrdersService{c: c}
 }
 
+// NewModifyOrderService init modify order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewModifyBatchOrdersService init modify batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
+// NewListOrderAmendmentService init list order amendment service
+func (c *Client) NewListOrderAmendmentService() *ListOrderAmendmentService {
+	return &ListOrderAmendmentService{c: c}
+}
+
 // NewGetOrderService init get order service
 func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
@@ -531,6 +588,26 @@ func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
 }
 
+// NewExchangeInfoRegistry init exchange info registry, the cached exchange info
+// is refreshed by lookups once it is older than ttl
+func (c *Client) NewExchangeInfoRegistry(ttl time.Duration) *ExchangeInfoRegistry {
+	return newExchangeInfoRegistry(c, ttl)
+}
+
+// NewCountdownCancelAllService init countdown cancel all service
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
+// NewCountdownHeartbeat init a heartbeat refreshing the countdown of symbols
+func (c *Client) NewCountdownHeartbeat(countdown time.Duration, symbols ...string) *CountdownHeartbeat {
+	arm := func(ctx context.Context, symbol string, countdownTime int64, opts ...RequestOption) error {
+		_, err := c.NewCountdownCancelAllService().Symbol(symbol).CountdownTime(countdownTime).Do(ctx, opts...)
+		return err
+	}
+	return common.NewCountdownHeartbeat(arm, countdown, symbols...)
+}
+
 // NewPremiumIndexService init premium index service
 func (c *Client) NewPremiumIndexService() *PremiumIndexService {
 	return &PremiumIndexService{c: c}
@@ -561,6 +638,31 @@ func (c *Client) NewChangeLeverageService() *ChangeLeverageService {
 	return &ChangeLeverageService{c: c}
 }
 
+// NewFundingInfoService init funding info service
+func (c *Client) NewFundingInfoService() *FundingInfoService {
+	return &FundingInfoService{c: c}
+}
+
+// NewGetIndexInfoService init composite index info service
+func (c *Client) NewGetIndexInfoService() *GetIndexInfoService {
+	return &GetIndexInfoService{c: c}
+}
+
+// NewGetAssetIndexService init multi-assets mode asset index service
+func (c *Client) NewGetAssetIndexService() *GetAssetIndexService {
+	return &GetAssetIndexService{c: c}
+}
+
+// NewGetADLQuantileService init ADL quantile service
+func (c *Client) NewGetADLQuantileService() *GetADLQuantileService {
+	return &GetADLQuantileService{c: c}
+}
+
+// NewGetAPITradingStatusService init API trading status service
+func (c *Client) NewGetAPITradingStatusService() *GetAPITradingStatusService {
+	return &GetAPITradingStatusService{c: c}
+}
+
 // NewGetLeverageBracketService init change leverage service
 func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
 	return &GetLeverageBracketService{c: c}
@@ -620,3 +722,55 @@ func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsServi
 func (c *Client) NewLongShortRatioService() *LongShortRatioService {
 	return &LongShortRatioService{c: c}
 }
+
+// NewTopLongShortAccountRatioService init top trader long short account ratio service
+func (c *Client) NewTopLongShortAccountRatioService() *TopLongShortAccountRatioService {
+	return &TopLongShortAccountRatioService{c: c}
+}
+
+// NewTopLongShortPositionRatioService init top trader long short position ratio service
+func (c *Client) NewTopLongShortPositionRatioService() *TopLongShortPositionRatioService {
+	return &TopLongShortPositionRatioService{c: c}
+}
+
+// NewTakerBuySellVolumeService init taker buy sell volume service
+func (c *Client) NewTakerBuySellVolumeService() *TakerBuySellVolumeService {
+	return &TakerBuySellVolumeService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
+
+// NewGetDownloadIDService init download id service for income, order or trade history
+func (c *Client) NewGetDownloadIDService(downloadType DownloadType) *GetDownloadIDService {
+	return &GetDownloadIDService{c: c, downloadType: downloadType}
+}
+
+// NewGetDownloadLinkService init download link service for income, order or trade history
+func (c *Client) NewGetDownloadLinkService(downloadType DownloadType) *GetDownloadLinkService {
+	return &GetDownloadLinkService{c: c, downloadType: downloadType}
+}
+
+// NewDownloadPoller init poller waiting for async downloads of income, order or trade history
+func (c *Client) NewDownloadPoller(downloadType DownloadType) *DownloadPoller {
+	return &DownloadPoller{c: c, downloadType: downloadType, interval: defaultDownloadPollInterval}
+}
+
+// NewBracketOrder init a LIMIT entry order protected by take-profit and stop-loss exits
+func (c *Client) NewBracketOrder() *BracketOrder {
+	return &BracketOrder{
+		c:         c,
+		orderType: OrderTypeLimit,
+		prefix:    newBracketClientOrderIDPrefix(),
+		status:    BracketOrderStatus{State: BracketStatePending},
+	}
+}
+
+// NewPositionTracker init a tracker of the positions of the account
+func (c *Client) NewPositionTracker() *PositionTracker {
+	t := &PositionTracker{c: c}
+	t.PositionBook = common.NewPositionBook(unrealizedPnL).Tracks(t.tracks)
+	return t
+}
@@ -0,0 +1,67 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// CountdownCancelAllService set a countdown after which all the open orders
+// of the symbol are cancelled. Each call resets the countdown, a countdown
+// time of 0 disables it.
+type CountdownCancelAllService struct {
+	c             *Client
+	symbol        string
+	countdownTime int64
+}
+
+// Symbol set symbol
+func (s *CountdownCancelAllService) Symbol(symbol string) *CountdownCancelAllService {
+	s.symbol = symbol
+	return s
+}
+
+// CountdownTime set countdownTime in milliseconds
+func (s *CountdownCancelAllService) CountdownTime(countdownTime int64) *CountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAllResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/fapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"symbol":        s.symbol,
+		"countdownTime": s.countdownTime,
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAllResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllResponse define countdown cancel all response
+type CountdownCancelAllResponse struct {
+	Symbol        string `json:"symbol"`
+	CountdownTime string `json:"countdownTime"`
+}
+
+// HeartbeatMissHandler handle a failed countdown refresh, see
+// common.HeartbeatMissHandler
+type HeartbeatMissHandler = common.HeartbeatMissHandler
+
+// CountdownHeartbeat keep refreshing the countdown of a set of symbols with
+// CountdownCancelAllService, see common.CountdownHeartbeat
+type CountdownHeartbeat = common.CountdownHeartbeat[RequestOption]
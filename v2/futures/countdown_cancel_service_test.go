@@ -0,0 +1,49 @@
+package futures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type countdownCancelServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCountdownCancelService(t *testing.T) {
+	suite.Run(t, new(countdownCancelServiceTestSuite))
+}
+
+func (s *countdownCancelServiceTestSuite) TestCountdownCancelAll() {
+	data := []byte(`{"symbol": "BTCUSDT", "countdownTime": "100000"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        "BTCUSDT",
+			"countdownTime": 100000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCountdownCancelAllService().Symbol("BTCUSDT").CountdownTime(100000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CountdownCancelAllResponse{Symbol: "BTCUSDT", CountdownTime: "100000"}, res)
+}
+
+func (s *countdownCancelServiceTestSuite) TestHeartbeatDisarm() {
+	s.mockDo([]byte(`{"symbol": "BTCUSDT", "countdownTime": "0"}`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        "BTCUSDT",
+			"countdownTime": 0,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewCountdownHeartbeat(time.Minute, "BTCUSDT").Disarm(newContext())
+	s.r().NoError(err)
+}
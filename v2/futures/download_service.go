@@ -0,0 +1,372 @@
+package futures
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DownloadType define the kind of history exported by the async download endpoints
+type DownloadType string
+
+// Global enums
+const (
+	DownloadTypeIncome DownloadType = "income"
+	DownloadTypeOrder  DownloadType = "order"
+	DownloadTypeTrade  DownloadType = "trade"
+
+	DownloadStatusCompleted  = "completed"
+	DownloadStatusProcessing = "processing"
+
+	defaultDownloadPollInterval = 10 * time.Second
+	downloadTimeLayout          = "2006-01-02 15:04:05"
+)
+
+// ErrDownloadExpired is returned when a download link expired before it was fetched
+var ErrDownloadExpired = errors.New("download link expired")
+
+// GetDownloadIDService get download id for income, order or trade history
+type GetDownloadIDService struct {
+	c            *Client
+	downloadType DownloadType
+	startTime    int64
+	endTime      int64
+}
+
+// StartTime set startTime
+func (s *GetDownloadIDService) StartTime(startTime int64) *GetDownloadIDService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetDownloadIDService) EndTime(endTime int64) *GetDownloadIDService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *GetDownloadIDService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: fmt.Sprintf("/fapi/v1/%s/asyn", s.downloadType),
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"startTime": s.startTime,
+		"endTime":   s.endTime,
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadID)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DownloadID define download id info
+type DownloadID struct {
+	AvgCostTimestampOfLast30d int64  `json:"avgCostTimestampOfLast30d"`
+	DownloadID                string `json:"downloadId"`
+}
+
+// GetDownloadLinkService get download link by download id
+type GetDownloadLinkService struct {
+	c            *Client
+	downloadType DownloadType
+	downloadID   string
+}
+
+// DownloadID set downloadID
+func (s *GetDownloadLinkService) DownloadID(downloadID string) *GetDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: fmt.Sprintf("/fapi/v1/%s/asyn/id", s.downloadType),
+		secType:  secTypeSigned,
+	}
+	r.setParam("downloadId", s.downloadID)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadLink)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DownloadLink define download link info
+type DownloadLink struct {
+	DownloadID          string `json:"downloadId"`
+	Status              string `json:"status"`
+	URL                 string `json:"url"`
+	S3Link              string `json:"s3Link"`
+	Notified            bool   `json:"notified"`
+	ExpirationTimestamp int64  `json:"expirationTimestamp"`
+	IsExpired           *bool  `json:"isExpired"`
+}
+
+// DownloadPoller wait for an async download to be ready and stream its rows
+type DownloadPoller struct {
+	c            *Client
+	downloadType DownloadType
+	interval     time.Duration
+}
+
+// Interval set the delay between two download link lookups
+func (p *DownloadPoller) Interval(interval time.Duration) *DownloadPoller {
+	p.interval = interval
+	return p
+}
+
+// Wait poll the download link until it is completed, the link expires or ctx is done
+func (p *DownloadPoller) Wait(ctx context.Context, downloadID string, opts ...RequestOption) (*DownloadLink, error) {
+	interval := p.interval
+	if interval <= 0 {
+		interval = defaultDownloadPollInterval
+	}
+	for {
+		link, err := p.c.NewGetDownloadLinkService(p.downloadType).DownloadID(downloadID).Do(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if link.IsExpired != nil && *link.IsExpired {
+			return link, ErrDownloadExpired
+		}
+		if link.Status == DownloadStatusCompleted && link.URL != "" {
+			return link, nil
+		}
+		select {
+		case <-ctx.Done():
+			return link, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Stream wait for the download and call fn with every row of the downloaded csv file
+func (p *DownloadPoller) Stream(ctx context.Context, downloadID string, fn func(row DownloadRow) error, opts ...RequestOption) error {
+	link, err := p.Wait(ctx, downloadID, opts...)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodGet, link.URL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("download %s: unexpected status code %d", downloadID, res.StatusCode)
+	}
+	return ReadDownloadRows(res.Body, fn)
+}
+
+// DownloadRow define a row of a downloaded csv file keyed by normalized header.
+// Headers are lower-cased with everything but letters and digits removed,
+// so "Date(UTC)" becomes "dateutc".
+type DownloadRow map[string]string
+
+// ReadDownloadRows read a downloaded csv file from r and call fn with every row
+func ReadDownloadRows(r io.Reader, fn func(row DownloadRow) error) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	keys := make([]string, len(header))
+	for i, h := range header {
+		keys[i] = normalizeDownloadHeader(h)
+	}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		row := make(DownloadRow, len(keys))
+		for i, v := range record {
+			if i < len(keys) {
+				row[keys[i]] = strings.TrimSpace(v)
+			}
+		}
+		if err = fn(row); err != nil {
+			return err
+		}
+	}
+}
+
+func normalizeDownloadHeader(h string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(h) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Get return the value of the first of keys present in the row
+func (row DownloadRow) Get(keys ...string) string {
+	for _, k := range keys {
+		if v, ok := row[k]; ok {
+			return v
+		}
+	}
+	return ""
+}
+
+func (row DownloadRow) int64(keys ...string) (int64, error) {
+	v := row.Get(keys...)
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+func (row DownloadRow) time(keys ...string) (int64, error) {
+	v := row.Get(keys...)
+	if v == "" {
+		return 0, nil
+	}
+	if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return ms, nil
+	}
+	t, err := time.ParseInLocation(downloadTimeLayout, v, time.UTC)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano() / int64(time.Millisecond), nil
+}
+
+// IncomeRecord define a row of a downloaded income history file
+type IncomeRecord struct {
+	Time       int64
+	Symbol     string
+	IncomeType string
+	Income     string
+	Asset      string
+	Info       string
+	TranID     int64
+}
+
+// Income parse the row as an income record
+func (row DownloadRow) Income() (rec *IncomeRecord, err error) {
+	rec = &IncomeRecord{
+		Symbol:     row.Get("symbol"),
+		IncomeType: row.Get("incometype", "type"),
+		Income:     row.Get("amount", "income"),
+		Asset:      row.Get("asset", "coin"),
+		Info:       row.Get("info"),
+	}
+	if rec.Time, err = row.time("dateutc", "timeutc", "time", "date"); err != nil {
+		return nil, err
+	}
+	if rec.TranID, err = row.int64("tranid", "transactionid", "id"); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// OrderRecord define a row of a downloaded order history file
+type OrderRecord struct {
+	Time             int64
+	OrderID          int64
+	Symbol           string
+	Type             string
+	Side             string
+	Price            string
+	Quantity         string
+	AvgPrice         string
+	ExecutedQuantity string
+	Status           string
+}
+
+// Order parse the row as an order record
+func (row DownloadRow) Order() (rec *OrderRecord, err error) {
+	rec = &OrderRecord{
+		Symbol:           row.Get("symbol"),
+		Type:             row.Get("type", "ordertype"),
+		Side:             row.Get("side"),
+		Price:            row.Get("orderprice", "price"),
+		Quantity:         row.Get("orderamount", "origqty", "quantity"),
+		AvgPrice:         row.Get("avgtradeprice", "averageprice", "avgprice"),
+		ExecutedQuantity: row.Get("filled", "executedqty"),
+		Status:           row.Get("status"),
+	}
+	if rec.Time, err = row.time("dateutc", "timeutc", "time", "date"); err != nil {
+		return nil, err
+	}
+	if rec.OrderID, err = row.int64("orderno", "orderid"); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// TradeRecord define a row of a downloaded trade history file
+type TradeRecord struct {
+	Time            int64
+	TradeID         int64
+	OrderID         int64
+	Symbol          string
+	Side            string
+	Price           string
+	Quantity        string
+	QuoteQuantity   string
+	Commission      string
+	CommissionAsset string
+	RealizedPnl     string
+}
+
+// Trade parse the row as a trade record
+func (row DownloadRow) Trade() (rec *TradeRecord, err error) {
+	rec = &TradeRecord{
+		Symbol:          row.Get("symbol"),
+		Side:            row.Get("side"),
+		Price:           row.Get("price"),
+		Quantity:        row.Get("quantity", "qty"),
+		QuoteQuantity:   row.Get("amount", "quoteqty"),
+		Commission:      row.Get("fee", "commission"),
+		CommissionAsset: row.Get("feecoin", "feeasset", "commissionasset"),
+		RealizedPnl:     row.Get("realizedprofit", "realizedpnl"),
+	}
+	if rec.Time, err = row.time("dateutc", "timeutc", "time", "date"); err != nil {
+		return nil, err
+	}
+	if rec.TradeID, err = row.int64("tradeid", "id"); err != nil {
+		return nil, err
+	}
+	if rec.OrderID, err = row.int64("orderid", "orderno"); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
@@ -0,0 +1,184 @@
+package futures
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type downloadServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDownloadService(t *testing.T) {
+	suite.Run(t, new(downloadServiceTestSuite))
+}
+
+func (s *downloadServiceTestSuite) TestGetDownloadID() {
+	data := []byte(`{
+		"avgCostTimestampOfLast30d": 7241837,
+		"downloadId": "546975389218332672"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	var startTime int64 = 1576566020000
+	var endTime int64 = 1576566030000
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetDownloadIDService(DownloadTypeIncome).
+		StartTime(startTime).EndTime(endTime).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&DownloadID{
+		AvgCostTimestampOfLast30d: 7241837,
+		DownloadID:                "546975389218332672",
+	}, res)
+}
+
+func (s *downloadServiceTestSuite) TestGetDownloadLink() {
+	data := []byte(`{
+		"downloadId": "545923594199212032",
+		"status": "completed",
+		"url": "www.binance.com",
+		"s3Link": null,
+		"notified": true,
+		"expirationTimestamp": 1645009771000,
+		"isExpired": null
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	downloadID := "545923594199212032"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("downloadId", downloadID)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetDownloadLinkService(DownloadTypeTrade).DownloadID(downloadID).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&DownloadLink{
+		DownloadID:          downloadID,
+		Status:              DownloadStatusCompleted,
+		URL:                 "www.binance.com",
+		Notified:            true,
+		ExpirationTimestamp: 1645009771000,
+	}, res)
+}
+
+func (s *downloadServiceTestSuite) TestStreamIncome() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Date(UTC),Symbol,Income Type,Amount,Asset,Tran Id\n" +
+			"2022-02-14 10:00:00,BTCUSDT,REALIZED_PNL,-0.5,USDT,9689322392\n" +
+			"1644832800000,,TRANSFER,100,USDT,9689322393\n"))
+	}))
+	defer server.Close()
+
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{
+		"downloadId": "1", "status": "processing", "url": "", "notified": false,
+		"expirationTimestamp": -1, "isExpired": null
+	}`), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{
+		"downloadId": "1", "status": "completed", "url": "`+server.URL+`", "notified": true,
+		"expirationTimestamp": 1645009771000, "isExpired": null
+	}`), http.StatusOK), nil).Once()
+
+	var records []*IncomeRecord
+	err := s.client.NewDownloadPoller(DownloadTypeIncome).Interval(time.Millisecond).
+		Stream(newContext(), "1", func(row DownloadRow) error {
+			rec, err := row.Income()
+			if err != nil {
+				return err
+			}
+			records = append(records, rec)
+			return nil
+		})
+	s.r().NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+	s.r().Equal([]*IncomeRecord{
+		{
+			Time:       1644832800000,
+			Symbol:     "BTCUSDT",
+			IncomeType: "REALIZED_PNL",
+			Income:     "-0.5",
+			Asset:      "USDT",
+			TranID:     9689322392,
+		},
+		{
+			Time:       1644832800000,
+			IncomeType: "TRANSFER",
+			Income:     "100",
+			Asset:      "USDT",
+			TranID:     9689322393,
+		},
+	}, records)
+}
+
+func (s *downloadServiceTestSuite) TestWaitExpired() {
+	data := []byte(`{
+		"downloadId": "1", "status": "completed", "url": "www.binance.com",
+		"notified": true, "expirationTimestamp": 1645009771000, "isExpired": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	_, err := s.client.NewDownloadPoller(DownloadTypeOrder).Wait(newContext(), "1")
+	s.r().Equal(ErrDownloadExpired, err)
+}
+
+func (s *downloadServiceTestSuite) TestReadOrderAndTradeRows() {
+	orders := "Date(UTC),Order No,Symbol,Type,Side,Order Price,Order Amount,AvgTrade Price,Filled,Status\n" +
+		"2022-02-14 10:00:00,8886774,BTCUSDT,LIMIT,BUY,42000,0.01,41999.5,0.01,FILLED\n"
+	var order *OrderRecord
+	err := ReadDownloadRows(strings.NewReader(orders), func(row DownloadRow) (err error) {
+		order, err = row.Order()
+		return err
+	})
+	s.r().NoError(err)
+	s.r().Equal(&OrderRecord{
+		Time:             1644832800000,
+		OrderID:          8886774,
+		Symbol:           "BTCUSDT",
+		Type:             "LIMIT",
+		Side:             "BUY",
+		Price:            "42000",
+		Quantity:         "0.01",
+		AvgPrice:         "41999.5",
+		ExecutedQuantity: "0.01",
+		Status:           "FILLED",
+	}, order)
+
+	trades := "Date(UTC),Symbol,Side,Price,Quantity,Amount,Fee,Fee Coin,Realized Profit\n" +
+		"2022-02-14 10:00:00,BTCUSDT,SELL,42000,0.01,420,0.168,USDT,1.5\n"
+	var trade *TradeRecord
+	err = ReadDownloadRows(strings.NewReader(trades), func(row DownloadRow) (err error) {
+		trade, err = row.Trade()
+		return err
+	})
+	s.r().NoError(err)
+	s.r().Equal(&TradeRecord{
+		Time:            1644832800000,
+		Symbol:          "BTCUSDT",
+		Side:            "SELL",
+		Price:           "42000",
+		Quantity:        "0.01",
+		QuoteQuantity:   "420",
+		Commission:      "0.168",
+		CommissionAsset: "USDT",
+		RealizedPnl:     "1.5",
+	}, trade)
+
+	err = ReadDownloadRows(strings.NewReader("Date(UTC)\nyesterday\n"), func(row DownloadRow) error {
+		_, err := row.Trade()
+		return err
+	})
+	s.r().Error(err)
+}
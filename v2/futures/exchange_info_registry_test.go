@@ -0,0 +1,87 @@
+package futures
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type exchangeInfoRegistryTestSuite struct {
+	baseTestSuite
+}
+
+func TestExchangeInfoRegistry(t *testing.T) {
+	suite.Run(t, new(exchangeInfoRegistryTestSuite))
+}
+
+const registryExchangeInfo = `{
+	"timezone": "UTC",
+	"serverTime": 1565246363776,
+	"symbols": [
+		{
+			"symbol": "BTCUSDT",
+			"pair": "BTCUSDT",
+			"contractType": "PERPETUAL",
+			"status": "TRADING",
+			"baseAsset": "BTC",
+			"quoteAsset": "USDT",
+			"marginAsset": "USDT",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+				{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.01"},
+				{"filterType": "MAX_NUM_ORDERS", "limit": 200},
+				{"filterType": "MIN_NOTIONAL", "notional": "100"},
+				{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"}
+			]
+		},
+		{
+			"symbol": "ETHBTC",
+			"pair": "ETHBTC",
+			"contractType": "PERPETUAL",
+			"status": "SETTLING",
+			"baseAsset": "ETH",
+			"quoteAsset": "BTC",
+			"marginAsset": "BTC",
+			"filters": []
+		}
+	]
+}`
+
+func (s *exchangeInfoRegistryTestSuite) mockDoOnce(data string) {
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(data), http.StatusOK), nil).Once()
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestRegistry() {
+	s.mockDoOnce(registryExchangeInfo)
+	s.mockDoOnce(registryExchangeInfo)
+	registry := s.client.NewExchangeInfoRegistry(0)
+	r := s.r()
+
+	symbol, err := registry.Symbol(newContext(), "BTCUSDT")
+	r.NoError(err)
+	r.Equal("100", symbol.MinNotional.Notional)
+	r.Equal("1.0500", symbol.PercentPrice.MultiplierUp)
+	r.Equal(int64(200), symbol.MaxNumOrders.Limit)
+	r.Nil(symbol.MaxNumAlgoOrders)
+	r.Equal("27000.10", symbol.QuantizePrice(common.MustParseDecimal("27000.19"), common.RoundDown).String())
+	r.Equal("0.123", symbol.QuantizeQuantity(common.MustParseDecimal("0.1239"), common.RoundDown).String())
+	r.Equal("0.12", symbol.QuantizeMarketQuantity(common.MustParseDecimal("0.1239"), common.RoundDown).String())
+
+	_, err = registry.Symbol(newContext(), "ETHUSDT")
+	r.True(errors.Is(err, common.ErrSymbolNotFound))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+
+	r.Len(registry.SymbolsByBaseAsset("BTC"), 1)
+	r.Len(registry.SymbolsByQuoteAsset("BTC"), 1)
+	r.Len(registry.SymbolsByStatus(SymbolStatusTypeTrading), 1)
+
+	r.True(registry.ObserveError(&common.APIError{Code: -1121, Message: "Invalid symbol."}))
+	_, err = registry.Symbol(newContext(), "BTCUSDT")
+	r.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
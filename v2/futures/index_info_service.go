@@ -0,0 +1,108 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// GetIndexInfoService get the components of the composite indexes
+type GetIndexInfoService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetIndexInfoService) Symbol(symbol string) *GetIndexInfoService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetIndexInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*IndexInfo, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/indexInfo",
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*IndexInfo{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*IndexInfo, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*IndexInfo{}, err
+	}
+	return res, nil
+}
+
+// IndexInfo define the components of a composite index
+type IndexInfo struct {
+	Symbol        string            `json:"symbol"`
+	Time          int64             `json:"time"`
+	Component     string            `json:"component"`
+	BaseAssetList []*IndexBaseAsset `json:"baseAssetList"`
+}
+
+// IndexBaseAsset define a component of a composite index
+type IndexBaseAsset struct {
+	BaseAsset          string `json:"baseAsset"`
+	QuoteAsset         string `json:"quoteAsset"`
+	WeightInQuantity   string `json:"weightInQuantity"`
+	WeightInPercentage string `json:"weightInPercentage"`
+}
+
+// GetAssetIndexService get the asset index of the multi-assets mode
+type GetAssetIndexService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, e.g. ADAUSD
+func (s *GetAssetIndexService) Symbol(symbol string) *GetAssetIndexService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetAssetIndexService) Do(ctx context.Context, opts ...RequestOption) (res []*AssetIndex, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/assetIndex",
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*AssetIndex{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*AssetIndex, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*AssetIndex{}, err
+	}
+	return res, nil
+}
+
+// AssetIndex define the asset index of the multi-assets mode
+type AssetIndex struct {
+	Symbol                string `json:"symbol"`
+	Time                  int64  `json:"time"`
+	Index                 string `json:"index"`
+	BidBuffer             string `json:"bidBuffer"`
+	AskBuffer             string `json:"askBuffer"`
+	BidRate               string `json:"bidRate"`
+	AskRate               string `json:"askRate"`
+	AutoExchangeBidBuffer string `json:"autoExchangeBidBuffer"`
+	AutoExchangeAskBuffer string `json:"autoExchangeAskBuffer"`
+	AutoExchangeBidRate   string `json:"autoExchangeBidRate"`
+	AutoExchangeAskRate   string `json:"autoExchangeAskRate"`
+}
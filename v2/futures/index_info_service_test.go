@@ -0,0 +1,98 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type indexInfoServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIndexInfoService(t *testing.T) {
+	suite.Run(t, new(indexInfoServiceTestSuite))
+}
+
+func (s *indexInfoServiceTestSuite) TestGetIndexInfo() {
+	data := []byte(`{
+		"symbol": "DEFIUSDT",
+		"time": 1589437530011,
+		"component": "baseAsset",
+		"baseAssetList": [
+			{
+				"baseAsset": "BAL",
+				"quoteAsset": "USDT",
+				"weightInQuantity": "1.04406228",
+				"weightInPercentage": "0.02783900"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", "DEFIUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetIndexInfoService().Symbol("DEFIUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*IndexInfo{
+		{
+			Symbol:    "DEFIUSDT",
+			Time:      1589437530011,
+			Component: "baseAsset",
+			BaseAssetList: []*IndexBaseAsset{
+				{
+					BaseAsset:          "BAL",
+					QuoteAsset:         "USDT",
+					WeightInQuantity:   "1.04406228",
+					WeightInPercentage: "0.02783900",
+				},
+			},
+		},
+	}, res)
+}
+
+func (s *indexInfoServiceTestSuite) TestGetAssetIndex() {
+	data := []byte(`[
+		{
+			"symbol": "ADAUSD",
+			"time": 1635740268004,
+			"index": "1.92957370",
+			"bidBuffer": "0.10000000",
+			"askBuffer": "0.10000000",
+			"bidRate": "1.73661633",
+			"askRate": "2.12253107",
+			"autoExchangeBidBuffer": "0.05000000",
+			"autoExchangeAskBuffer": "0.05000000",
+			"autoExchangeBidRate": "1.83309501",
+			"autoExchangeAskRate": "2.02605238"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newRequest(), r)
+	})
+	res, err := s.client.NewGetAssetIndexService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*AssetIndex{
+		{
+			Symbol:                "ADAUSD",
+			Time:                  1635740268004,
+			Index:                 "1.92957370",
+			BidBuffer:             "0.10000000",
+			AskBuffer:             "0.10000000",
+			BidRate:               "1.73661633",
+			AskRate:               "2.12253107",
+			AutoExchangeBidBuffer: "0.05000000",
+			AutoExchangeAskBuffer: "0.05000000",
+			AutoExchangeBidRate:   "1.83309501",
+			AutoExchangeAskRate:   "2.02605238",
+		},
+	}, res)
+}
@@ -10,7 +10,7 @@ import (
 type LongShortRatioService struct {
 	c         *Client
 	symbol    string
-	period    string
+	period    PeriodType
 	limit     *int
 	startTime *int64
 	endTime   *int64
@@ -23,7 +23,7 @@ func (s *LongShortRatioService) Symbol(symbol string) *LongShortRatioService {
 }
 
 // Period set period interval
-func (s *LongShortRatioService) Period(period string) *LongShortRatioService {
+func (s *LongShortRatioService) Period(period PeriodType) *LongShortRatioService {
 	s.period = period
 	return s
 }
@@ -80,10 +80,153 @@ func (s *LongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (
 	return res, nil
 }
 
+// LongShortRatio define long/short ratio info. LongPosition and
+// ShortPosition are set by TopLongShortPositionRatioService, LongAccount and
+// ShortAccount by the account ratio services.
 type LongShortRatio struct {
 	Symbol         string `json:"symbol"`
 	LongShortRatio string `json:"longShortRatio"`
 	LongAccount    string `json:"longAccount"`
 	ShortAccount   string `json:"shortAccount"`
+	LongPosition   string `json:"longPosition"`
+	ShortPosition  string `json:"shortPosition"`
 	Timestamp      int64  `json:"timestamp"`
 }
+
+// TopLongShortAccountRatioService list the long/short account ratio of the top traders of a symbol
+type TopLongShortAccountRatioService struct {
+	c         *Client
+	symbol    string
+	period    PeriodType
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *TopLongShortAccountRatioService) Symbol(symbol string) *TopLongShortAccountRatioService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period interval
+func (s *TopLongShortAccountRatioService) Period(period PeriodType) *TopLongShortAccountRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TopLongShortAccountRatioService) Limit(limit int) *TopLongShortAccountRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TopLongShortAccountRatioService) StartTime(startTime int64) *TopLongShortAccountRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TopLongShortAccountRatioService) EndTime(endTime int64) *TopLongShortAccountRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TopLongShortAccountRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/topLongShortAccountRatio",
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	return res, nil
+}
+
+// TopLongShortPositionRatioService list the long/short position ratio of the top traders of a symbol
+type TopLongShortPositionRatioService struct {
+	c         *Client
+	symbol    string
+	period    PeriodType
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *TopLongShortPositionRatioService) Symbol(symbol string) *TopLongShortPositionRatioService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period interval
+func (s *TopLongShortPositionRatioService) Period(period PeriodType) *TopLongShortPositionRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TopLongShortPositionRatioService) Limit(limit int) *TopLongShortPositionRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TopLongShortPositionRatioService) StartTime(startTime int64) *TopLongShortPositionRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TopLongShortPositionRatioService) EndTime(endTime int64) *TopLongShortPositionRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TopLongShortPositionRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/topLongShortPositionRatio",
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	return res, nil
+}
@@ -83,3 +83,76 @@ func (s *longShortRatioServiceTestSuite) assertLongShortRatioEqual(e, a *LongSho
 	r.Equal(e.LongAccount, a.LongAccount, "LongAccount")
 	r.Equal(e.ShortAccount, a.ShortAccount, "ShortAccount")
 }
+
+func (s *longShortRatioServiceTestSuite) TestTopLongShortAccountRatio() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"longShortRatio": "1.8105",
+			"longAccount": "0.6442",
+			"shortAccount": "0.3558",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "BTCUSDT",
+			"period": Period1h,
+			"limit":  1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewTopLongShortAccountRatioService().Symbol("BTCUSDT").Period(Period1h).
+		Limit(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LongShortRatio{
+		{
+			Symbol:         "BTCUSDT",
+			LongShortRatio: "1.8105",
+			LongAccount:    "0.6442",
+			ShortAccount:   "0.3558",
+			Timestamp:      1583139600000,
+		},
+	}, res)
+}
+
+func (s *longShortRatioServiceTestSuite) TestTopLongShortPositionRatio() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"longShortRatio": "1.4342",
+			"longPosition": "0.5891",
+			"shortPosition": "0.4108",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    "BTCUSDT",
+			"period":    Period4h,
+			"startTime": 1583139600000,
+			"endTime":   1583154000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewTopLongShortPositionRatioService().Symbol("BTCUSDT").Period(Period4h).
+		StartTime(1583139600000).EndTime(1583154000000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LongShortRatio{
+		{
+			Symbol:         "BTCUSDT",
+			LongShortRatio: "1.4342",
+			LongPosition:   "0.5891",
+			ShortPosition:  "0.4108",
+			Timestamp:      1583139600000,
+		},
+	}, res)
+}
@@ -122,6 +122,40 @@ type FundingRate struct {
 	Time        int64  `json:"time"`
 }
 
+// FundingInfoService get the funding rate cap, floor and interval of the
+// symbols with adjusted funding
+type FundingInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *FundingInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*FundingInfo, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/fundingInfo",
+		secType:  secTypeNone,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*FundingInfo{}, err
+	}
+	res = make([]*FundingInfo, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*FundingInfo{}, err
+	}
+	return res, nil
+}
+
+// FundingInfo define the funding settings of a symbol
+type FundingInfo struct {
+	Symbol                   string `json:"symbol"`
+	AdjustedFundingRateCap   string `json:"adjustedFundingRateCap"`
+	AdjustedFundingRateFloor string `json:"adjustedFundingRateFloor"`
+	FundingIntervalHours     int    `json:"fundingIntervalHours"`
+	Disclaimer               bool   `json:"disclaimer"`
+}
+
 // GetLeverageBracketService get funding rate
 type GetLeverageBracketService struct {
 	c      *Client
@@ -195,3 +195,40 @@ func (s *getLeverageBracketServiceTestSuite) assertLeverageBracketEqual(e, a *Le
 	r.Equal(e.Brackets[0].MaintMarginRatio, a.Brackets[0].MaintMarginRatio, "MaintMarginRatio")
 	r.Equal(e.Brackets[0].Cum, a.Brackets[0].Cum, "Cum")
 }
+
+type fundingInfoServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestFundingInfoService(t *testing.T) {
+	suite.Run(t, new(fundingInfoServiceTestSuite))
+}
+
+func (s *fundingInfoServiceTestSuite) TestFundingInfo() {
+	data := []byte(`[
+		{
+			"symbol": "BLZUSDT",
+			"adjustedFundingRateCap": "0.02500000",
+			"adjustedFundingRateFloor": "-0.02500000",
+			"fundingIntervalHours": 8,
+			"disclaimer": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newRequest(), r)
+	})
+	res, err := s.client.NewFundingInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*FundingInfo{
+		{
+			Symbol:                   "BLZUSDT",
+			AdjustedFundingRateCap:   "0.02500000",
+			AdjustedFundingRateFloor: "-0.02500000",
+			FundingIntervalHours:     8,
+		},
+	}, res)
+}
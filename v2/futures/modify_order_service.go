@@ -0,0 +1,330 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ModifyOrderService modify the price and quantity of an open LIMIT order in place
+type ModifyOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	side              SideType
+	quantity          string
+	price             string
+	priceMatch        *PriceMatchType
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side, it must be the side of the order
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = price
+	return s
+}
+
+// PriceMatch set priceMatch, it can't be sent along with price
+func (s *ModifyOrderService) PriceMatch(priceMatch PriceMatchType) *ModifyOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *ModifyOrderService) QuantityDecimal(d common.Decimal) *ModifyOrderService {
+	return s.Quantity(d.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *ModifyOrderService) PriceDecimal(d common.Decimal) *ModifyOrderService {
+	return s.Price(d.String())
+}
+
+func (s *ModifyOrderService) params() params {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	if s.price != "" {
+		m["price"] = s.price
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	return m
+}
+
+// Do send request
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/fapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.params())
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ModifyBatchOrdersService modify a list of orders, sent in batches of 5,
+// the maximum allowed per request
+type ModifyBatchOrdersService struct {
+	c      *Client
+	orders []*ModifyOrderService
+}
+
+// ModifyBatchOrdersResponse define modify batch orders response
+type ModifyBatchOrdersResponse struct {
+	// Orders hold the modified orders, the failed ones are skipped
+	Orders []*Order
+	// Results hold one result per order, in the order of OrderList
+	Results []*BatchOrderResult
+}
+
+// BatchOrderResult define the result of one order of a batch request,
+// exactly one of Order and Error is set
+type BatchOrderResult struct {
+	Order *Order
+	Error *common.APIError
+}
+
+// OrderList set the orders to modify
+func (s *ModifyBatchOrdersService) OrderList(orders []*ModifyOrderService) *ModifyBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request. The failure of an order is reported in its result. If a
+// request fails, the results of the batches sent before it are returned
+// along with the error.
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyBatchOrdersResponse, err error) {
+	res = new(ModifyBatchOrdersResponse)
+	for start := 0; start < len(s.orders); start += maxBatchOrders {
+		end := start + maxBatchOrders
+		if end > len(s.orders) {
+			end = len(s.orders)
+		}
+		results, err := s.modifyBatch(ctx, s.orders[start:end], opts...)
+		if err != nil {
+			return res, err
+		}
+		for _, result := range results {
+			res.Results = append(res.Results, result)
+			if result.Order != nil {
+				res.Orders = append(res.Orders, result.Order)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *ModifyBatchOrdersService) modifyBatch(ctx context.Context, batch []*ModifyOrderService, opts ...RequestOption) ([]*BatchOrderResult, error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/fapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	orders := []params{}
+	for _, order := range batch {
+		orders = append(orders, order.params())
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r.setFormParams(params{
+		"batchOrders": string(b),
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return parseBatchOrderResults(data)
+}
+
+// parseBatchOrderResults decode a batch response, where each element is
+// either an order or a {code,msg} error
+func parseBatchOrderResults(data []byte) ([]*BatchOrderResult, error) {
+	rawMessages := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(data, &rawMessages); err != nil {
+		return nil, err
+	}
+	results := make([]*BatchOrderResult, 0, len(rawMessages))
+	for _, raw := range rawMessages {
+		var probe struct {
+			Code *int64 `json:"code"`
+		}
+		if err := json.Unmarshal(raw, &probe); err != nil {
+			return nil, err
+		}
+		// successful orders don't have a code
+		if probe.Code != nil {
+			apiErr := new(common.APIError)
+			if err := json.Unmarshal(raw, apiErr); err != nil {
+				return nil, err
+			}
+			results = append(results, &BatchOrderResult{Error: apiErr})
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(raw, o); err != nil {
+			return nil, err
+		}
+		results = append(results, &BatchOrderResult{Order: o})
+	}
+	return results, nil
+}
+
+// ListOrderAmendmentService list the amendment history of an order
+type ListOrderAmendmentService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	startTime         *int64
+	endTime           *int64
+	limit             *int
+}
+
+// Symbol set symbol
+func (s *ListOrderAmendmentService) Symbol(symbol string) *ListOrderAmendmentService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *ListOrderAmendmentService) OrderID(orderID int64) *ListOrderAmendmentService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ListOrderAmendmentService) OrigClientOrderID(origClientOrderID string) *ListOrderAmendmentService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOrderAmendmentService) StartTime(startTime int64) *ListOrderAmendmentService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOrderAmendmentService) EndTime(endTime int64) *ListOrderAmendmentService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListOrderAmendmentService) Limit(limit int) *ListOrderAmendmentService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListOrderAmendmentService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderAmendment, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/orderAmendment",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	res = make([]*OrderAmendment, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	return res, nil
+}
+
+// OrderAmendment define an amendment of an order
+type OrderAmendment struct {
+	AmendmentID   int64                `json:"amendmentId"`
+	Symbol        string               `json:"symbol"`
+	Pair          string               `json:"pair"`
+	OrderID       int64                `json:"orderId"`
+	ClientOrderID string               `json:"clientOrderId"`
+	Time          int64                `json:"time"`
+	Amendment     OrderAmendmentDetail `json:"amendment"`
+}
+
+// OrderAmendmentDetail define the changes of an amendment, Count is the
+// number of times the order was amended
+type OrderAmendmentDetail struct {
+	Price        OrderAmendmentChange `json:"price"`
+	OrigQuantity OrderAmendmentChange `json:"origQty"`
+	Count        int                  `json:"count"`
+}
+
+// OrderAmendmentChange define a value before and after an amendment
+type OrderAmendmentChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
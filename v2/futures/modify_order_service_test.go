@@ -0,0 +1,225 @@
+package futures
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type modifyOrderServiceTestSuite struct {
+	baseOrderTestSuite
+}
+
+func TestModifyOrderService(t *testing.T) {
+	suite.Run(t, new(modifyOrderServiceTestSuite))
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSDT",
+		"pair": "BTCUSDT",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumQuote": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   "BTCUSDT",
+			"orderId":  20072994037,
+			"side":     SideTypeBuy,
+			"quantity": "1",
+			"price":    "30005",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(20072994037).
+		Side(SideTypeBuy).Quantity("1").Price("30005").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(20072994037), res.OrderID)
+	r.Equal("30005", res.Price)
+	r.Equal("1", res.OrigQuantity)
+	r.Equal(OrderStatusTypeNew, res.Status)
+	r.Equal(int64(1629182711600), res.UpdateTime)
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 42042723,
+			"symbol": "BTCUSDT",
+			"status": "NEW",
+			"clientOrderId": "Ne7DEEvLvv8b9Q6dF0eODy",
+			"price": "29000",
+			"origQty": "0.002",
+			"side": "BUY",
+			"type": "LIMIT",
+			"updateTime": 1629182711600
+		},
+		{
+			"code": -2022,
+			"msg": "ReduceOnly Order is rejected."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"orderId":42042723,"price":"29000","quantity":"0.002","side":"BUY","symbol":"BTCUSDT"},` +
+				`{"origClientOrderId":"myOrder2","price":"31000","quantity":"0.002","side":"SELL","symbol":"BTCUSDT"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyBatchOrdersService().OrderList([]*ModifyOrderService{
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(42042723).
+			Side(SideTypeBuy).Quantity("0.002").Price("29000"),
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").OrigClientOrderID("myOrder2").
+			Side(SideTypeSell).Quantity("0.002").Price("31000"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 1)
+	r.Equal(int64(42042723), res.Orders[0].OrderID)
+	r.Len(res.Results, 2)
+	r.Same(res.Orders[0], res.Results[0].Order)
+	r.Nil(res.Results[0].Error)
+	r.Nil(res.Results[1].Order)
+	r.Equal(int64(-2022), res.Results[1].Error.Code)
+	r.Equal("ReduceOnly Order is rejected.", res.Results[1].Error.Message)
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyBatchOrdersChunked() {
+	s.client.Client.do = s.client.do
+	first := `[` + strings.Repeat(`{"orderId": 1, "symbol": "BTCUSDT", "status": "NEW"},`, 4) +
+		`{"orderId": 5, "symbol": "BTCUSDT", "status": "NEW"}]`
+	second := `[{"code": -2013, "msg": "Order does not exist."},{"orderId": 7, "symbol": "BTCUSDT", "status": "NEW"}]`
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(first), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(second), http.StatusOK), nil).Once()
+
+	var batchSizes []int
+	s.assertReq(func(r *request) {
+		var orders []params
+		s.r().NoError(json.Unmarshal([]byte(r.form.Get("batchOrders")), &orders))
+		batchSizes = append(batchSizes, len(orders))
+	})
+	orders := make([]*ModifyOrderService, 7)
+	for i := range orders {
+		orders[i] = s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(int64(i + 1)).
+			Side(SideTypeBuy).Quantity("0.002").Price("29000")
+	}
+	res, err := s.client.NewModifyBatchOrdersService().OrderList(orders).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]int{5, 2}, batchSizes)
+	r.Len(res.Results, 7)
+	r.Len(res.Orders, 6)
+	r.Nil(res.Results[5].Order)
+	r.Equal(int64(-2013), res.Results[5].Error.Code)
+	r.Equal(int64(7), res.Results[6].Order.OrderID)
+	r.Same(res.Results[6].Order, res.Orders[5])
+}
+
+func (s *modifyOrderServiceTestSuite) TestListOrderAmendment() {
+	data := []byte(`[
+		{
+			"amendmentId": 5363,
+			"symbol": "BTCUSDT",
+			"pair": "BTCUSDT",
+			"orderId": 20072994037,
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"time": 1629184560899,
+			"amendment": {
+				"price": {"before": "30004", "after": "30003.2"},
+				"origQty": {"before": "1", "after": "1"},
+				"count": 3
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":  "BTCUSDT",
+			"orderId": 20072994037,
+			"limit":   50,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListOrderAmendmentService().Symbol("BTCUSDT").OrderID(20072994037).
+		Limit(50).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*OrderAmendment{
+		{
+			AmendmentID:   5363,
+			Symbol:        "BTCUSDT",
+			Pair:          "BTCUSDT",
+			OrderID:       20072994037,
+			ClientOrderID: "LJ9R4QZDihCaS8UAOOLpgW",
+			Time:          1629184560899,
+			Amendment: OrderAmendmentDetail{
+				Price:        OrderAmendmentChange{Before: "30004", After: "30003.2"},
+				OrigQuantity: OrderAmendmentChange{Before: "1", After: "1"},
+				Count:        3,
+			},
+		},
+	}, res)
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyOrderPriceMatch() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSDT",
+		"status": "NEW",
+		"price": "30010",
+		"origQty": "1",
+		"side": "BUY",
+		"type": "LIMIT",
+		"priceMatch": "OPPONENT_10",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":     "BTCUSDT",
+			"orderId":    20072994037,
+			"side":       SideTypeBuy,
+			"quantity":   "1",
+			"priceMatch": PriceMatchOpponent10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(20072994037).
+		Side(SideTypeBuy).Quantity("1").PriceMatch(PriceMatchOpponent10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(PriceMatchOpponent10, res.PriceMatch)
+	r.Equal("30010", res.Price)
+}
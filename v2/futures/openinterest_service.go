@@ -49,7 +49,7 @@ type OpenInterest struct {
 type OpenInterestStatisticsService struct {
 	c         *Client
 	symbol    string
-	period    string
+	period    PeriodType
 	limit     *int
 	startTime *int64
 	endTime   *int64
@@ -62,7 +62,7 @@ func (s *OpenInterestStatisticsService) Symbol(symbol string) *OpenInterestStati
 }
 
 // Period set period interval
-func (s *OpenInterestStatisticsService) Period(period string) *OpenInterestStatisticsService {
+func (s *OpenInterestStatisticsService) Period(period PeriodType) *OpenInterestStatisticsService {
 	s.period = period
 	return s
 }
@@ -7,27 +7,34 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateOrderService create order
 type CreateOrderService struct {
-	c                *Client
-	symbol           string
-	side             SideType
-	positionSide     *PositionSideType
-	orderType        OrderType
-	timeInForce      *TimeInForceType
-	quantity         string
-	reduceOnly       *bool
-	price            *string
-	newClientOrderID *string
-	stopPrice        *string
-	workingType      *WorkingType
-	activationPrice  *string
-	callbackRate     *string
-	priceProtect     *bool
-	newOrderRespType NewOrderRespType
-	closePosition    *bool
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	positionSide            *PositionSideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	quantity                string
+	reduceOnly              *bool
+	price                   *string
+	newClientOrderID        *string
+	stopPrice               *string
+	workingType             *WorkingType
+	activationPrice         *string
+	callbackRate            *string
+	priceProtect            *bool
+	newOrderRespType        NewOrderRespType
+	closePosition           *bool
+	selfTradePreventionMode *STPModeType
+	priceMatch              *PriceMatchType
+	goodTillDate            *int64
+	validateSymbol          *Symbol
+	validateOpts            []ValidateOption
 }
 
 // Symbol set symbol
@@ -126,13 +133,47 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOrderService) SelfTradePreventionMode(mode STPModeType) *CreateOrderService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
 
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
-	}
+// PriceMatch set priceMatch, the price is then set by the matching engine
+// and must not be sent
+func (s *CreateOrderService) PriceMatch(priceMatch PriceMatchType) *CreateOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// GoodTillDate set goodTillDate in milliseconds, required with TimeInForceTypeGTD
+func (s *CreateOrderService) GoodTillDate(goodTillDate int64) *CreateOrderService {
+	s.goodTillDate = &goodTillDate
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(d common.Decimal) *CreateOrderService {
+	return s.Quantity(d.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(d common.Decimal) *CreateOrderService {
+	return s.Price(d.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOrderService) StopPriceDecimal(d common.Decimal) *CreateOrderService {
+	return s.StopPrice(d.String())
+}
+
+// ActivationPriceDecimal set activationPrice from a decimal
+func (s *CreateOrderService) ActivationPriceDecimal(d common.Decimal) *CreateOrderService {
+	return s.ActivationPrice(d.String())
+}
+
+// params return the params of the order, in a single or a batch request
+func (s *CreateOrderService) params() params {
 	m := params{
 		"symbol":           s.symbol,
 		"side":             s.side,
@@ -175,7 +216,31 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
-	r.setFormParams(m)
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	if s.goodTillDate != nil {
+		m["goodTillDate"] = *s.goodTillDate
+	}
+	return m
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	if s.validateSymbol != nil {
+		if err = s.Validate(s.validateSymbol, s.validateOpts...); err != nil {
+			return []byte{}, &http.Header{}, err
+		}
+	}
+
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.params())
 	data, header, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
@@ -202,29 +267,62 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 
 // CreateOrderResponse define create order response
 type CreateOrderResponse struct {
-	Symbol            string           `json:"symbol"`
-	OrderID           int64            `json:"orderId"`
-	ClientOrderID     string           `json:"clientOrderId"`
-	Price             string           `json:"price"`
-	OrigQuantity      string           `json:"origQty"`
-	ExecutedQuantity  string           `json:"executedQty"`
-	CumQuote          string           `json:"cumQuote"`
-	ReduceOnly        bool             `json:"reduceOnly"`
-	Status            OrderStatusType  `json:"status"`
-	StopPrice         string           `json:"stopPrice"`
-	TimeInForce       TimeInForceType  `json:"timeInForce"`
-	Type              OrderType        `json:"type"`
-	Side              SideType         `json:"side"`
-	UpdateTime        int64            `json:"updateTime"`
-	WorkingType       WorkingType      `json:"workingType"`
-	ActivatePrice     string           `json:"activatePrice"`
-	PriceRate         string           `json:"priceRate"`
-	AvgPrice          string           `json:"avgPrice"`
-	PositionSide      PositionSideType `json:"positionSide"`
-	ClosePosition     bool             `json:"closePosition"`
-	PriceProtect      bool             `json:"priceProtect"`
-	RateLimitOrder10s string           `json:"rateLimitOrder10s,omitempty"`
-	RateLimitOrder1m  string           `json:"rateLimitOrder1m,omitempty"`
+	Symbol                  string           `json:"symbol"`
+	OrderID                 int64            `json:"orderId"`
+	ClientOrderID           string           `json:"clientOrderId"`
+	Price                   string           `json:"price"`
+	OrigQuantity            string           `json:"origQty"`
+	ExecutedQuantity        string           `json:"executedQty"`
+	CumQuote                string           `json:"cumQuote"`
+	ReduceOnly              bool             `json:"reduceOnly"`
+	Status                  OrderStatusType  `json:"status"`
+	StopPrice               string           `json:"stopPrice"`
+	TimeInForce             TimeInForceType  `json:"timeInForce"`
+	Type                    OrderType        `json:"type"`
+	Side                    SideType         `json:"side"`
+	UpdateTime              int64            `json:"updateTime"`
+	WorkingType             WorkingType      `json:"workingType"`
+	ActivatePrice           string           `json:"activatePrice"`
+	PriceRate               string           `json:"priceRate"`
+	AvgPrice                string           `json:"avgPrice"`
+	PositionSide            PositionSideType `json:"positionSide"`
+	ClosePosition           bool             `json:"closePosition"`
+	PriceProtect            bool             `json:"priceProtect"`
+	PriceMatch              PriceMatchType   `json:"priceMatch"`
+	SelfTradePreventionMode STPModeType      `json:"selfTradePreventionMode"`
+	GoodTillDate            int64            `json:"goodTillDate"`
+	RateLimitOrder10s       string           `json:"rateLimitOrder10s,omitempty"`
+	RateLimitOrder1m        string           `json:"rateLimitOrder1m,omitempty"`
+}
+
+// PriceDecimal return Price as an exact decimal
+func (r *CreateOrderResponse) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as an exact decimal
+func (r *CreateOrderResponse) OrigQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as an exact decimal
+func (r *CreateOrderResponse) ExecutedQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.ExecutedQuantity)
+}
+
+// CumQuoteDecimal return CumQuote as an exact decimal
+func (r *CreateOrderResponse) CumQuoteDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.CumQuote)
+}
+
+// StopPriceDecimal return StopPrice as an exact decimal
+func (r *CreateOrderResponse) StopPriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.StopPrice)
+}
+
+// AvgPriceDecimal return AvgPrice as an exact decimal
+func (r *CreateOrderResponse) AvgPriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.AvgPrice)
 }
 
 // ListOpenOrdersService list opened orders
@@ -366,30 +464,63 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 
 // Order define order info
 type Order struct {
-	Symbol           string           `json:"symbol"`
-	OrderID          int64            `json:"orderId"`
-	ClientOrderID    string           `json:"clientOrderId"`
-	Price            string           `json:"price"`
-	ReduceOnly       bool             `json:"reduceOnly"`
-	OrigQuantity     string           `json:"origQty"`
-	ExecutedQuantity string           `json:"executedQty"`
-	CumQuantity      string           `json:"cumQty"`
-	CumQuote         string           `json:"cumQuote"`
-	Status           OrderStatusType  `json:"status"`
-	TimeInForce      TimeInForceType  `json:"timeInForce"`
-	Type             OrderType        `json:"type"`
-	Side             SideType         `json:"side"`
-	StopPrice        string           `json:"stopPrice"`
-	Time             int64            `json:"time"`
-	UpdateTime       int64            `json:"updateTime"`
-	WorkingType      WorkingType      `json:"workingType"`
-	ActivatePrice    string           `json:"activatePrice"`
-	PriceRate        string           `json:"priceRate"`
-	AvgPrice         string           `json:"avgPrice"`
-	OrigType         string           `json:"origType"`
-	PositionSide     PositionSideType `json:"positionSide"`
-	PriceProtect     bool             `json:"priceProtect"`
-	ClosePosition    bool             `json:"closePosition"`
+	Symbol                  string           `json:"symbol"`
+	OrderID                 int64            `json:"orderId"`
+	ClientOrderID           string           `json:"clientOrderId"`
+	Price                   string           `json:"price"`
+	ReduceOnly              bool             `json:"reduceOnly"`
+	OrigQuantity            string           `json:"origQty"`
+	ExecutedQuantity        string           `json:"executedQty"`
+	CumQuantity             string           `json:"cumQty"`
+	CumQuote                string           `json:"cumQuote"`
+	Status                  OrderStatusType  `json:"status"`
+	TimeInForce             TimeInForceType  `json:"timeInForce"`
+	Type                    OrderType        `json:"type"`
+	Side                    SideType         `json:"side"`
+	StopPrice               string           `json:"stopPrice"`
+	Time                    int64            `json:"time"`
+	UpdateTime              int64            `json:"updateTime"`
+	WorkingType             WorkingType      `json:"workingType"`
+	ActivatePrice           string           `json:"activatePrice"`
+	PriceRate               string           `json:"priceRate"`
+	AvgPrice                string           `json:"avgPrice"`
+	OrigType                string           `json:"origType"`
+	PositionSide            PositionSideType `json:"positionSide"`
+	PriceProtect            bool             `json:"priceProtect"`
+	ClosePosition           bool             `json:"closePosition"`
+	PriceMatch              PriceMatchType   `json:"priceMatch"`
+	SelfTradePreventionMode STPModeType      `json:"selfTradePreventionMode"`
+	GoodTillDate            int64            `json:"goodTillDate"`
+}
+
+// PriceDecimal return Price as an exact decimal
+func (o *Order) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as an exact decimal
+func (o *Order) OrigQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as an exact decimal
+func (o *Order) ExecutedQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.ExecutedQuantity)
+}
+
+// CumQuoteDecimal return CumQuote as an exact decimal
+func (o *Order) CumQuoteDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.CumQuote)
+}
+
+// StopPriceDecimal return StopPrice as an exact decimal
+func (o *Order) StopPriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.StopPrice)
+}
+
+// AvgPriceDecimal return AvgPrice as an exact decimal
+func (o *Order) AvgPriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.AvgPrice)
 }
 
 // ListOrdersService all account orders; active, canceled, or filled
@@ -797,111 +928,74 @@ type UserLiquidationOrder struct {
 	UpdateTime       int64            `json:"updateTime"`
 }
 
+// maxBatchOrders is the maximum number of orders of a batchOrders request
+const maxBatchOrders = 5
+
+// CreateBatchOrdersService create multiple orders, the orders are sent in
+// batches of 5, the maximum allowed per request
 type CreateBatchOrdersService struct {
 	c      *Client
 	orders []*CreateOrderService
 }
 
+// CreateBatchOrdersResponse define create batch orders response
 type CreateBatchOrdersResponse struct {
+	// Orders hold the created orders, the failed ones are skipped
 	Orders []*Order
+	// Results hold one result per order, in the order of OrderList
+	Results []*BatchOrderResult
 }
 
+// OrderList set the orders to create
 func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
 	s.orders = orders
 	return s
 }
 
+// Do send request. The failure of an order is reported in its result. If a
+// request fails, the results of the batches sent before it are returned
+// along with the error.
 func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	res = new(CreateBatchOrdersResponse)
+	for start := 0; start < len(s.orders); start += maxBatchOrders {
+		end := start + maxBatchOrders
+		if end > len(s.orders) {
+			end = len(s.orders)
+		}
+		results, err := s.createBatch(ctx, s.orders[start:end], opts...)
+		if err != nil {
+			return res, err
+		}
+		for _, result := range results {
+			res.Results = append(res.Results, result)
+			if result.Order != nil {
+				res.Orders = append(res.Orders, result.Order)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *CreateBatchOrdersService) createBatch(ctx context.Context, batch []*CreateOrderService, opts ...RequestOption) ([]*BatchOrderResult, error) {
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/fapi/v1/batchOrders",
 		secType:  secTypeSigned,
 	}
-
 	orders := []params{}
-	for _, order := range s.orders {
-		m := params{
-			"symbol":           order.symbol,
-			"side":             order.side,
-			"type":             order.orderType,
-			"quantity":         order.quantity,
-			"newOrderRespType": order.newOrderRespType,
-		}
-
-		if order.positionSide != nil {
-			m["positionSide"] = *order.positionSide
-		}
-		if order.timeInForce != nil {
-			m["timeInForce"] = *order.timeInForce
-		}
-		if order.reduceOnly != nil {
-			m["reduceOnly"] = *order.reduceOnly
-		}
-		if order.price != nil {
-			m["price"] = *order.price
-		}
-		if order.newClientOrderID != nil {
-			m["newClientOrderId"] = *order.newClientOrderID
-		}
-		if order.stopPrice != nil {
-			m["stopPrice"] = *order.stopPrice
-		}
-		if order.workingType != nil {
-			m["workingType"] = *order.workingType
-		}
-		if order.priceProtect != nil {
-			m["priceProtect"] = *order.priceProtect
-		}
-		if order.activationPrice != nil {
-			m["activationPrice"] = *order.activationPrice
-		}
-		if order.callbackRate != nil {
-			m["callbackRate"] = *order.callbackRate
-		}
-		if order.closePosition != nil {
-			m["closePosition"] = *order.closePosition
-		}
-		orders = append(orders, m)
+	for _, order := range batch {
+		orders = append(orders, order.params())
 	}
 	b, err := json.Marshal(orders)
 	if err != nil {
-		return &CreateBatchOrdersResponse{}, err
+		return nil, err
 	}
-	m := params{
+	r.setFormParams(params{
 		"batchOrders": string(b),
-	}
-
-	r.setFormParams(m)
-
+	})
 	data, _, err := s.c.callAPI(ctx, r, opts...)
-
 	if err != nil {
-		return &CreateBatchOrdersResponse{}, err
-	}
-
-	rawMessages := make([]*json.RawMessage, 0)
-
-	err = json.Unmarshal(data, &rawMessages)
-
-	if err != nil {
-		return &CreateBatchOrdersResponse{}, err
-	}
-
-	batchCreateOrdersResponse := new(CreateBatchOrdersResponse)
-
-	for _, j := range rawMessages {
-		o := new(Order)
-		if err := json.Unmarshal(*j, o); err != nil {
-			return &CreateBatchOrdersResponse{}, err
-		}
-
-		if o.ClientOrderID != "" {
-			batchCreateOrdersResponse.Orders = append(batchCreateOrdersResponse.Orders, o)
-			continue
-		}
-
+		return nil, err
 	}
-
-	return batchCreateOrdersResponse, nil
-
+	return parseBatchOrderResults(data)
 }
@@ -1,8 +1,12 @@
 package futures
 
 import (
+	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -113,6 +117,243 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.assertCreateOrderResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderSelfTradePrevention() {
+	data := []byte(`{
+		"clientOrderId": "testOrder",
+		"orderId": 22542181,
+		"origQty": "0.01",
+		"price": "27000.10",
+		"side": "BUY",
+		"status": "NEW",
+		"symbol": "BTCUSDT",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"selfTradePreventionMode": "EXPIRE_MAKER"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeBuy,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTC,
+			"quantity":                "0.01",
+			"price":                   "27000.10",
+			"selfTradePreventionMode": STPModeExpireMaker,
+			"newOrderRespType":        NewOrderRespTypeACK,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.01").Price("27000.10").
+		SelfTradePreventionMode(STPModeExpireMaker).NewOrderResponseType(NewOrderRespTypeACK).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(STPModeExpireMaker, res.SelfTradePreventionMode)
+}
+func (s *orderServiceTestSuite) TestCreateOrderWithDecimals() {
+	data := []byte(`{
+		"clientOrderId": "testOrder",
+		"cumQuote": "0",
+		"executedQty": "0",
+		"orderId": 22542179,
+		"origQty": "0.003",
+		"price": "27000.10",
+		"side": "BUY",
+		"status": "NEW",
+		"symbol": "BTCUSDT",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"updateTime": 1566818724722
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	tickSize := common.MustParseDecimal("0.10")
+	stepSize := common.MustParseDecimal("0.001")
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTC,
+			"quantity":         "0.003",
+			"price":            "27000.10",
+			"newOrderRespType": NewOrderRespTypeACK,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).
+		QuantityDecimal(common.MustParseDecimal("0.0035").Quantize(stepSize, common.RoundDown)).
+		PriceDecimal(common.MustParseDecimal("27000.149").Quantize(tickSize, common.RoundDown)).
+		NewOrderResponseType(NewOrderRespTypeACK).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	price, err := res.PriceDecimal()
+	r.NoError(err)
+	r.Equal("27000.10", price.String())
+	origQty, err := res.OrigQuantityDecimal()
+	r.NoError(err)
+	r.True(origQty.Equal(common.NewDecimal(3, 3)))
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderGoodTillDate() {
+	data := []byte(`{
+		"clientOrderId": "testOrder",
+		"cumQuote": "0",
+		"executedQty": "0",
+		"orderId": 22542180,
+		"origQty": "0.01",
+		"price": "27000.10",
+		"side": "BUY",
+		"status": "NEW",
+		"symbol": "BTCUSDT",
+		"timeInForce": "GTD",
+		"type": "LIMIT",
+		"updateTime": 1693207080000,
+		"priceMatch": "QUEUE_5",
+		"selfTradePreventionMode": "EXPIRE_BOTH",
+		"goodTillDate": 1693207680000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeBuy,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTD,
+			"quantity":                "0.01",
+			"priceMatch":              PriceMatchQueue5,
+			"goodTillDate":            1693207680000,
+			"selfTradePreventionMode": STPModeExpireBoth,
+			"newOrderRespType":        NewOrderRespTypeRESULT,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTD).GoodTillDate(1693207680000).
+		Quantity("0.01").PriceMatch(PriceMatchQueue5).SelfTradePreventionMode(STPModeExpireBoth).
+		NewOrderResponseType(NewOrderRespTypeRESULT).Do(newContext())
+	s.r().NoError(err)
+	e := &CreateOrderResponse{
+		ClientOrderID:           "testOrder",
+		CumQuote:                "0",
+		ExecutedQuantity:        "0",
+		OrderID:                 22542180,
+		OrigQuantity:            "0.01",
+		Price:                   "27000.10",
+		Side:                    SideTypeBuy,
+		Status:                  OrderStatusTypeNew,
+		Symbol:                  "BTCUSDT",
+		TimeInForce:             TimeInForceTypeGTD,
+		Type:                    OrderTypeLimit,
+		UpdateTime:              1693207080000,
+		PriceMatch:              PriceMatchQueue5,
+		SelfTradePreventionMode: STPModeExpireBoth,
+		GoodTillDate:            1693207680000,
+	}
+	s.assertCreateOrderResponseEqual(e, res)
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "batch1",
+			"orderId": 22542181,
+			"origQty": "0.01",
+			"price": "27000.10",
+			"side": "BUY",
+			"status": "NEW",
+			"symbol": "BTCUSDT",
+			"timeInForce": "GTD",
+			"type": "LIMIT",
+			"priceMatch": "OPPONENT",
+			"selfTradePreventionMode": "EXPIRE_TAKER",
+			"goodTillDate": 1693207680000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"goodTillDate":1693207680000,"newClientOrderId":"batch1","newOrderRespType":"",` +
+				`"priceMatch":"OPPONENT","quantity":"0.01","selfTradePreventionMode":"EXPIRE_TAKER",` +
+				`"side":"BUY","symbol":"BTCUSDT","timeInForce":"GTD","type":"LIMIT"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+			TimeInForce(TimeInForceTypeGTD).GoodTillDate(1693207680000).Quantity("0.01").
+			PriceMatch(PriceMatchOpponent).SelfTradePreventionMode(STPModeExpireTaker).
+			NewClientOrderID("batch1"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 1)
+	s.assertOrderEqual(&Order{
+		Symbol:                  "BTCUSDT",
+		OrderID:                 22542181,
+		ClientOrderID:           "batch1",
+		Price:                   "27000.10",
+		OrigQuantity:            "0.01",
+		Status:                  OrderStatusTypeNew,
+		TimeInForce:             TimeInForceTypeGTD,
+		Type:                    OrderTypeLimit,
+		Side:                    SideTypeBuy,
+		PriceMatch:              PriceMatchOpponent,
+		SelfTradePreventionMode: STPModeExpireTaker,
+		GoodTillDate:            1693207680000,
+	}, res.Orders[0])
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrdersChunked() {
+	s.client.Client.do = s.client.do
+	first := `[` + strings.Repeat(`{"orderId": 1, "symbol": "BTCUSDT", "status": "NEW"},`, 4) +
+		`{"code": -2019, "msg": "Margin is insufficient."}]`
+	second := `[{"orderId": 6, "symbol": "BTCUSDT", "status": "NEW"}]`
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(first), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(second), http.StatusOK), nil).Once()
+
+	var batchSizes []int
+	s.assertReq(func(r *request) {
+		var orders []params
+		s.r().NoError(json.Unmarshal([]byte(r.form.Get("batchOrders")), &orders))
+		batchSizes = append(batchSizes, len(orders))
+	})
+	orders := make([]*CreateOrderService, 6)
+	for i := range orders {
+		orders[i] = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeMarket).Quantity("0.01")
+	}
+	res, err := s.client.NewCreateBatchOrdersService().OrderList(orders).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]int{5, 1}, batchSizes)
+	r.Len(res.Results, 6)
+	r.Len(res.Orders, 5)
+	r.Nil(res.Results[4].Order)
+	r.Equal(int64(-2019), res.Results[4].Error.Code)
+	r.Equal(int64(6), res.Results[5].Order.OrderID)
+	r.Same(res.Results[5].Order, res.Orders[4])
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrdersRequestError() {
+	s.mockDo([]byte(`{"code": -1102, "msg": "Param 'batchOrders' empty."}`), nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	res, err := s.client.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeMarket).Quantity("0.01"),
+	}).Do(newContext())
+	r := s.r()
+	r.True(common.IsAPIError(err))
+	r.Empty(res.Results)
+}
+
 func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderResponse) {
 	r := s.r()
 	r.Equal(e.ClientOrderID, a.ClientOrderID, "ClientOrderID")
@@ -135,6 +376,9 @@ func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderRes
 	r.Equal(e.ActivatePrice, a.ActivatePrice, "ActivatePrice")
 	r.Equal(e.PriceRate, a.PriceRate, "PriceRate")
 	r.Equal(e.ClosePosition, a.ClosePosition, "ClosePosition")
+	r.Equal(e.PriceMatch, a.PriceMatch, "PriceMatch")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.GoodTillDate, a.GoodTillDate, "GoodTillDate")
 }
 
 func (s *orderServiceTestSuite) TestListOpenOrders() {
@@ -226,6 +470,9 @@ func (s *baseOrderTestSuite) assertOrderEqual(e, a *Order) {
 	r.Equal(e.PriceRate, a.PriceRate, "PriceRate")
 	r.Equal(e.PositionSide, a.PositionSide, "PositionSide")
 	r.Equal(e.PriceProtect, a.PriceProtect, "PriceProtect")
+	r.Equal(e.PriceMatch, a.PriceMatch, "PriceMatch")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.GoodTillDate, a.GoodTillDate, "GoodTillDate")
 }
 
 func (s *orderServiceTestSuite) TestGetOpenOrder() {
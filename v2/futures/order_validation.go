@@ -0,0 +1,306 @@
+package futures
+
+import (
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderValidationError define an order rejected by the pre-flight validation.
+// Filter is empty when the failure is not caused by a symbol filter, e.g. a
+// mandatory parameter is missing or the order type is not allowed.
+type OrderValidationError struct {
+	Symbol string
+	Filter SymbolFilterType
+	Param  string
+	Value  string
+	Reason string
+}
+
+// Error return the violated filter, parameter and reason
+func (e *OrderValidationError) Error() string {
+	if e.Filter == "" {
+		return fmt.Sprintf("<OrderValidationError> symbol=%s, param=%s, value=%s: %s", e.Symbol, e.Param, e.Value, e.Reason)
+	}
+	return fmt.Sprintf("<OrderValidationError> symbol=%s, filter=%s, param=%s, value=%s: %s", e.Symbol, e.Filter, e.Param, e.Value, e.Reason)
+}
+
+// IsOrderValidationError check if e is an order validation error
+func IsOrderValidationError(e error) bool {
+	_, ok := e.(*OrderValidationError)
+	return ok
+}
+
+// ValidateOption define option type for the pre-flight order validation
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	referencePrice *common.Decimal
+}
+
+// WithReferencePrice set the mark price used to check the PERCENT_PRICE filter
+// and the notional of MARKET orders. Those checks are skipped without a
+// reference price.
+func WithReferencePrice(price common.Decimal) ValidateOption {
+	return func(o *validateOptions) {
+		o.referencePrice = &price
+	}
+}
+
+// Validate check the order parameters against the filters of symbol, as
+// returned by ExchangeInfoService, and return an *OrderValidationError for the
+// first violation found
+func (s *CreateOrderService) Validate(symbol *Symbol, opts ...ValidateOption) error {
+	v := &orderValidator{symbol: symbol, name: s.symbol}
+	for _, opt := range opts {
+		opt(&v.opts)
+	}
+	switch {
+	case s.symbol == "":
+		v.fail("", "symbol", "", "is required")
+	case symbol == nil:
+		v.fail("", "symbol", s.symbol, "no exchange info for symbol")
+	case symbol.Symbol != s.symbol:
+		v.fail("", "symbol", s.symbol, fmt.Sprintf("exchange info is for %s", symbol.Symbol))
+	case symbol.Status != string(SymbolStatusTypeTrading):
+		v.fail("", "symbol", s.symbol, fmt.Sprintf("symbol status is %s", symbol.Status))
+	}
+	v.require("side", s.side != "")
+	v.require("type", s.orderType != "")
+	if v.err != nil {
+		return v.err
+	}
+	v.checkOrderType(s.orderType)
+	if s.timeInForce != nil {
+		v.checkTimeInForce(*s.timeInForce)
+		if *s.timeInForce == TimeInForceTypeGTD {
+			v.require("goodTillDate", s.goodTillDate != nil)
+		}
+	}
+	hasPriceMatch := s.priceMatch != nil && *s.priceMatch != PriceMatchNone
+	if hasPriceMatch && s.price != nil {
+		v.fail("", "price", *s.price, "cannot be sent with priceMatch")
+	}
+
+	closePosition := s.closePosition != nil && *s.closePosition
+	isMarket := false
+	switch s.orderType {
+	case OrderTypeLimit:
+		v.require("timeInForce", s.timeInForce != nil)
+		v.require("quantity", s.quantity != "")
+		v.require("price", s.price != nil || hasPriceMatch)
+	case OrderTypeMarket:
+		isMarket = true
+		v.require("quantity", s.quantity != "")
+		if s.price != nil {
+			v.fail("", "price", *s.price, "cannot be sent with a MARKET order")
+		}
+	case OrderTypeStop, OrderTypeTakeProfit:
+		v.require("quantity", s.quantity != "")
+		v.require("price", s.price != nil || hasPriceMatch)
+		v.require("stopPrice", s.stopPrice != nil)
+	case OrderTypeStopMarket, OrderTypeTakeProfitMarket:
+		isMarket = true
+		v.require("stopPrice", s.stopPrice != nil)
+		if !closePosition {
+			v.require("quantity", s.quantity != "")
+		}
+	case OrderTypeTrailingStopMarket:
+		isMarket = true
+		v.require("quantity", s.quantity != "")
+		v.require("callbackRate", s.callbackRate != nil)
+	}
+	if closePosition {
+		switch {
+		case s.orderType != OrderTypeStopMarket && s.orderType != OrderTypeTakeProfitMarket:
+			v.fail("", "closePosition", "true", "only supported by STOP_MARKET and TAKE_PROFIT_MARKET orders")
+		case s.quantity != "":
+			v.fail("", "quantity", s.quantity, "cannot be sent with closePosition")
+		case s.reduceOnly != nil && *s.reduceOnly:
+			v.fail("", "reduceOnly", "true", "cannot be sent with closePosition")
+		}
+	}
+	if v.err != nil {
+		return v.err
+	}
+
+	var price, quantity common.Decimal
+	if s.price != nil {
+		price = v.decimal("price", *s.price)
+		v.checkPrice("price", *s.price)
+		v.checkPercentPrice(s.side, price)
+	}
+	if s.stopPrice != nil {
+		v.checkPrice("stopPrice", *s.stopPrice)
+		if s.price == nil {
+			price = v.decimal("stopPrice", *s.stopPrice)
+		}
+	}
+	if s.activationPrice != nil {
+		v.checkPrice("activationPrice", *s.activationPrice)
+	}
+	if s.callbackRate != nil {
+		rate := v.decimal("callbackRate", *s.callbackRate)
+		if v.err == nil && (rate.LessThan(minCallbackRate) || rate.GreaterThan(maxCallbackRate)) {
+			v.fail("", "callbackRate", *s.callbackRate, fmt.Sprintf("out of range [%s, %s]", minCallbackRate, maxCallbackRate))
+		}
+	}
+	if s.quantity != "" {
+		quantity = v.decimal("quantity", s.quantity)
+		if f := symbol.MarketLotSizeFilter(); isMarket && f != nil {
+			v.checkQuantity(SymbolFilterTypeMarketLotSize, f.MinQuantity, f.MaxQuantity, f.StepSize, "quantity", s.quantity)
+		} else if f := symbol.LotSizeFilter(); f != nil {
+			v.checkQuantity(SymbolFilterTypeLotSize, f.MinQuantity, f.MaxQuantity, f.StepSize, "quantity", s.quantity)
+		}
+	}
+	// reduce only orders are exempt from the min notional filter
+	if s.quantity != "" && (s.reduceOnly == nil || !*s.reduceOnly) {
+		switch {
+		case !price.IsZero():
+			v.checkNotional(price.Mul(quantity))
+		case v.opts.referencePrice != nil:
+			v.checkNotional(v.opts.referencePrice.Mul(quantity))
+		}
+	}
+	return v.err
+}
+
+// ValidateWith make Do and Test validate the order against symbol before sending it
+func (s *CreateOrderService) ValidateWith(symbol *Symbol, opts ...ValidateOption) *CreateOrderService {
+	s.validateSymbol = symbol
+	s.validateOpts = opts
+	return s
+}
+
+// bounds of callbackRate for TRAILING_STOP_MARKET orders, in percent
+var (
+	minCallbackRate = common.NewDecimal(1, 1)
+	maxCallbackRate = common.NewDecimalFromInt(10)
+)
+
+// orderValidator keep the first validation error, so checks can be chained
+type orderValidator struct {
+	symbol *Symbol
+	name   string
+	opts   validateOptions
+	err    error
+}
+
+func (v *orderValidator) fail(filter SymbolFilterType, param, value, reason string) {
+	if v.err != nil {
+		return
+	}
+	v.err = &OrderValidationError{
+		Symbol: v.name,
+		Filter: filter,
+		Param:  param,
+		Value:  value,
+		Reason: reason,
+	}
+}
+
+func (v *orderValidator) require(param string, present bool) {
+	if !present {
+		v.fail("", param, "", "is required")
+	}
+}
+
+// decimal parse a parameter or a filter value, recording an error if it is not a number
+func (v *orderValidator) decimal(param, value string) common.Decimal {
+	d, err := common.ParseDecimal(value)
+	if err != nil {
+		v.fail("", param, value, "invalid number")
+	}
+	return d
+}
+
+func (v *orderValidator) checkOrderType(orderType OrderType) {
+	if len(v.symbol.OrderType) == 0 {
+		return
+	}
+	for _, t := range v.symbol.OrderType {
+		if t == orderType {
+			return
+		}
+	}
+	v.fail("", "type", string(orderType), "order type is not allowed")
+}
+
+func (v *orderValidator) checkTimeInForce(timeInForce TimeInForceType) {
+	if len(v.symbol.TimeInForce) == 0 {
+		return
+	}
+	for _, t := range v.symbol.TimeInForce {
+		if t == timeInForce {
+			return
+		}
+	}
+	v.fail("", "timeInForce", string(timeInForce), "time in force is not allowed")
+}
+
+func (v *orderValidator) checkPrice(param, value string) {
+	f := v.symbol.PriceFilter()
+	if v.err != nil || f == nil {
+		return
+	}
+	price := v.decimal(param, value)
+	min := v.decimal("minPrice", f.MinPrice)
+	max := v.decimal("maxPrice", f.MaxPrice)
+	tick := v.decimal("tickSize", f.TickSize)
+	switch {
+	case v.err != nil:
+	case !min.IsZero() && price.LessThan(min):
+		v.fail(SymbolFilterTypePrice, param, value, fmt.Sprintf("lower than minPrice %s", f.MinPrice))
+	case !max.IsZero() && price.GreaterThan(max):
+		v.fail(SymbolFilterTypePrice, param, value, fmt.Sprintf("greater than maxPrice %s", f.MaxPrice))
+	case !price.Sub(min).IsMultipleOf(tick):
+		v.fail(SymbolFilterTypePrice, param, value, fmt.Sprintf("not a multiple of tickSize %s", f.TickSize))
+	}
+}
+
+func (v *orderValidator) checkQuantity(filter SymbolFilterType, minQty, maxQty, stepSize, param, value string) {
+	if v.err != nil {
+		return
+	}
+	quantity := v.decimal(param, value)
+	min := v.decimal("minQty", minQty)
+	max := v.decimal("maxQty", maxQty)
+	step := v.decimal("stepSize", stepSize)
+	switch {
+	case v.err != nil:
+	case quantity.LessThan(min) || !quantity.IsPositive():
+		v.fail(filter, param, value, fmt.Sprintf("lower than minQty %s", minQty))
+	case !max.IsZero() && quantity.GreaterThan(max):
+		v.fail(filter, param, value, fmt.Sprintf("greater than maxQty %s", maxQty))
+	case !quantity.Sub(min).IsMultipleOf(step):
+		v.fail(filter, param, value, fmt.Sprintf("not a multiple of stepSize %s", stepSize))
+	}
+}
+
+func (v *orderValidator) checkNotional(notional common.Decimal) {
+	f := v.symbol.MinNotionalFilter()
+	if v.err != nil || f == nil {
+		return
+	}
+	min := v.decimal("notional", f.Notional)
+	if v.err == nil && notional.LessThan(min) {
+		v.fail(SymbolFilterTypeMinNotional, "notional", notional.String(), fmt.Sprintf("lower than notional %s", f.Notional))
+	}
+}
+
+func (v *orderValidator) checkPercentPrice(side SideType, price common.Decimal) {
+	f := v.symbol.PercentPriceFilter()
+	if v.err != nil || f == nil || v.opts.referencePrice == nil {
+		return
+	}
+	ref := *v.opts.referencePrice
+	maxPrice := ref.Mul(v.decimal("multiplierUp", f.MultiplierUp))
+	minPrice := ref.Mul(v.decimal("multiplierDown", f.MultiplierDown))
+	switch {
+	case v.err != nil:
+	case side == SideTypeBuy && price.GreaterThan(maxPrice):
+		v.fail(SymbolFilterTypePercentPrice, "price", price.String(), fmt.Sprintf("greater than %s (%s x %s)", maxPrice, ref, f.MultiplierUp))
+	case side == SideTypeSell && price.LessThan(minPrice):
+		v.fail(SymbolFilterTypePercentPrice, "price", price.String(), fmt.Sprintf("lower than %s (%s x %s)", minPrice, ref, f.MultiplierDown))
+	}
+}
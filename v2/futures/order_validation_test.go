@@ -0,0 +1,127 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderValidationTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderValidation(t *testing.T) {
+	suite.Run(t, new(orderValidationTestSuite))
+}
+
+func newValidationSymbol() *Symbol {
+	return &Symbol{
+		Symbol:     "BTCUSDT",
+		Status:     string(SymbolStatusTypeTrading),
+		BaseAsset:  "BTC",
+		QuoteAsset: "USDT",
+		OrderType: []OrderType{OrderTypeLimit, OrderTypeMarket, OrderTypeStop, OrderTypeStopMarket,
+			OrderTypeTakeProfit, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket},
+		TimeInForce: []TimeInForceType{TimeInForceTypeGTC, TimeInForceTypeIOC, TimeInForceTypeFOK, TimeInForceTypeGTD},
+		Filters: []map[string]interface{}{
+			{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+			{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.001"},
+			{"filterType": "MAX_NUM_ORDERS", "limit": float64(200)},
+			{"filterType": "MIN_NOTIONAL", "notional": "100"},
+			{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"},
+		},
+	}
+}
+
+func (s *orderValidationTestSuite) assertValidationError(err error, filter SymbolFilterType, param string) {
+	r := s.r()
+	r.Error(err)
+	r.True(IsOrderValidationError(err), err.Error())
+	e := err.(*OrderValidationError)
+	r.Equal(filter, e.Filter, err.Error())
+	r.Equal(param, e.Param, err.Error())
+}
+
+func (s *orderValidationTestSuite) newLimitOrder() *CreateOrderService {
+	return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.01").Price("27000.1")
+}
+
+func (s *orderValidationTestSuite) TestValidateCreateOrder() {
+	symbol := newValidationSymbol()
+	s.r().NoError(s.newLimitOrder().Validate(symbol))
+	s.r().NoError(s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeStopMarket).StopPrice("26000").ClosePosition(true).Validate(symbol))
+	s.r().NoError(s.newLimitOrder().Quantity("0.001").ReduceOnly(true).Validate(symbol))
+	s.r().NoError(s.newLimitOrder().TimeInForce(TimeInForceTypeGTD).GoodTillDate(1693207680000).Validate(symbol))
+	s.r().NoError(s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.01").PriceMatch(PriceMatchQueue).Validate(symbol))
+
+	tests := []struct {
+		name   string
+		order  *CreateOrderService
+		filter SymbolFilterType
+		param  string
+	}{
+		{"missing price", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1"), "", "price"},
+		{"missing timeInForce", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeLimit).Quantity("1").Price("27000"), "", "timeInForce"},
+		{"missing stop price", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeTakeProfitMarket).Quantity("1"), "", "stopPrice"},
+		{"missing callback rate", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeTrailingStopMarket).Quantity("1"), "", "callbackRate"},
+		{"callback rate range", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeTrailingStopMarket).Quantity("1").CallbackRate("10.1"), "", "callbackRate"},
+		{"close position with quantity", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeStopMarket).StopPrice("26000").ClosePosition(true).Quantity("1"), "", "quantity"},
+		{"close position on limit", s.newLimitOrder().ClosePosition(true), "", "closePosition"},
+		{"market with price", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeMarket).Quantity("1").Price("27000"), "", "price"},
+		{"missing goodTillDate", s.newLimitOrder().TimeInForce(TimeInForceTypeGTD), "", "goodTillDate"},
+		{"price with priceMatch", s.newLimitOrder().PriceMatch(PriceMatchOpponent), "", "price"},
+		{"time in force not allowed", s.newLimitOrder().TimeInForce(TimeInForceTypeGTX), "", "timeInForce"},
+		{"wrong symbol", s.newLimitOrder().Symbol("ETHUSDT"), "", "symbol"},
+		{"tick size", s.newLimitOrder().Price("27000.15"), SymbolFilterTypePrice, "price"},
+		{"min price", s.newLimitOrder().Price("500"), SymbolFilterTypePrice, "price"},
+		{"stop price tick size", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeStopMarket).Quantity("1").StopPrice("26000.01"), SymbolFilterTypePrice, "stopPrice"},
+		{"step size", s.newLimitOrder().Quantity("0.0105"), SymbolFilterTypeLotSize, "quantity"},
+		{"max quantity", s.newLimitOrder().Quantity("1000.001"), SymbolFilterTypeLotSize, "quantity"},
+		{"market lot size", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeMarket).Quantity("121"), SymbolFilterTypeMarketLotSize, "quantity"},
+		{"min notional", s.newLimitOrder().Quantity("0.001"), SymbolFilterTypeMinNotional, "notional"},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.assertValidationError(tt.order.Validate(symbol), tt.filter, tt.param)
+		})
+	}
+
+	symbol.Status = string(SymbolStatusTypeBreak)
+	s.assertValidationError(s.newLimitOrder().Validate(symbol), "", "symbol")
+}
+
+func (s *orderValidationTestSuite) TestValidatePercentPrice() {
+	symbol := newValidationSymbol()
+	markPrice := WithReferencePrice(common.MustParseDecimal("27000"))
+	s.r().NoError(s.newLimitOrder().Price("28350").Validate(symbol, markPrice))
+	s.assertValidationError(s.newLimitOrder().Price("28350.1").Validate(symbol, markPrice),
+		SymbolFilterTypePercentPrice, "price")
+	s.assertValidationError(s.newLimitOrder().Side(SideTypeSell).Price("25649.9").Validate(symbol, markPrice),
+		SymbolFilterTypePercentPrice, "price")
+
+	market := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("0.003")
+	s.r().NoError(market.Validate(symbol))
+	s.assertValidationError(market.Validate(symbol, markPrice), SymbolFilterTypeMinNotional, "notional")
+}
+
+func (s *orderValidationTestSuite) TestValidateWithSkipsRequest() {
+	s.mockDo([]byte(`{}`), nil)
+	_, err := s.newLimitOrder().Price("27000.01").ValidateWith(newValidationSymbol()).Do(newContext())
+	s.assertValidationError(err, SymbolFilterTypePrice, "price")
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
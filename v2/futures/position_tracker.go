@@ -0,0 +1,135 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// TrackedPosition define a position kept up to date by a PositionTracker
+type TrackedPosition = common.TrackedPosition[PositionSideType, MarginType]
+
+// PositionChangeHandler handle a position change
+type PositionChangeHandler func(p TrackedPosition)
+
+type positionRecord = common.PositionRecord[PositionSideType, MarginType]
+
+// PositionTracker keep the positions of the account from a REST snapshot
+// and the ACCOUNT_UPDATE and ORDER_TRADE_UPDATE events of the user data
+// stream, marked to the mark price stream.
+//
+// The user data stream is not resumed after a disconnection: once its
+// doneC is closed, call ServeUserData again with a valid listen key and the
+// tracker resyncs from a new snapshot.
+type PositionTracker struct {
+	*common.PositionBook[PositionSideType, MarginType]
+	c          *Client
+	symbol     string
+	errHandler ErrHandler
+}
+
+// Symbol set symbol, to track the positions of a single symbol
+func (t *PositionTracker) Symbol(symbol string) *PositionTracker {
+	t.symbol = symbol
+	return t
+}
+
+// OnChange set the handler called with every position that changed
+func (t *PositionTracker) OnChange(handler PositionChangeHandler) *PositionTracker {
+	t.PositionBook.OnChange(handler)
+	return t
+}
+
+// OnError set the handler of errors met while handling stream events
+func (t *PositionTracker) OnError(errHandler ErrHandler) *PositionTracker {
+	t.errHandler = errHandler
+	return t
+}
+
+func (t *PositionTracker) tracks(symbol string) bool {
+	return t.symbol == "" || symbol == t.symbol
+}
+
+func (t *PositionTracker) handleErr(err error) {
+	if err != nil && t.errHandler != nil {
+		t.errHandler(err)
+	}
+}
+
+// Sync replace the positions with a REST snapshot. ACCOUNT_UPDATE events
+// received meanwhile are applied on top of it if they are newer than the
+// request, the realised PnL is kept.
+func (t *PositionTracker) Sync(ctx context.Context, opts ...RequestOption) error {
+	return t.SyncRecords(func() ([]positionRecord, int64, error) {
+		requestTime := currentTimestamp() - t.c.TimeOffset
+		res, err := t.c.NewGetPositionRiskService().Symbol(t.symbol).Do(ctx, opts...)
+		if err != nil {
+			return nil, requestTime, err
+		}
+		return snapshotRecords(res), requestTime, nil
+	})
+}
+
+func snapshotRecords(res []*PositionRisk) []positionRecord {
+	records := make([]positionRecord, 0, len(res))
+	for _, r := range res {
+		records = append(records, positionRecord{
+			Symbol:       r.Symbol,
+			PositionSide: PositionSideType(r.PositionSide),
+			Amount:       r.PositionAmt,
+			EntryPrice:   r.EntryPrice,
+			MarkPrice:    r.MarkPrice,
+			MarginType:   MarginType(r.MarginType),
+		})
+	}
+	return records
+}
+
+// HandleUserData apply the ACCOUNT_UPDATE and ORDER_TRADE_UPDATE events
+func (t *PositionTracker) HandleUserData(event *WsUserDataEvent) {
+	switch event.Event {
+	case UserDataEventTypeAccountUpdate:
+		records := make([]positionRecord, 0, len(event.AccountUpdate.Positions))
+		for _, u := range event.AccountUpdate.Positions {
+			records = append(records, positionRecord{
+				Symbol:       u.Symbol,
+				PositionSide: u.Side,
+				Amount:       u.Amount,
+				EntryPrice:   u.EntryPrice,
+				MarginType:   u.MarginType,
+			})
+		}
+		t.handleErr(t.ApplyAccountRecords(event.TransactionTime, records))
+	case UserDataEventTypeOrderTradeUpdate:
+		u := event.OrderTradeUpdate
+		t.handleErr(t.ApplyTradeRecord(u.Symbol, u.PositionSide, u.TradeID, u.RealizedPnL))
+	}
+}
+
+// HandleMarkPrice mark the positions of the event symbol to its mark price
+func (t *PositionTracker) HandleMarkPrice(event *WsMarkPriceEvent) {
+	t.handleErr(t.ApplyMarkPriceRecord(event.Symbol, event.MarkPrice))
+}
+
+// ServeUserData serve the user data stream of listenKey into the tracker
+// and resync it once connected
+func (t *PositionTracker) ServeUserData(ctx context.Context, listenKey string) (doneC, stopC chan struct{}, err error) {
+	return common.ServeSynced(func() (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, t.HandleUserData, t.handleErr)
+	}, func() error {
+		return t.Sync(ctx)
+	})
+}
+
+// ServeMarkPrice serve the mark price stream of symbol into the tracker
+func (t *PositionTracker) ServeMarkPrice(symbol string) (doneC, stopC chan struct{}, err error) {
+	return WsMarkPriceServe(symbol, t.HandleMarkPrice, t.handleErr)
+}
+
+// unrealizedPnL return the profit of closing the position at the mark price
+func unrealizedPnL(p *TrackedPosition) common.Decimal {
+	if p.Amount.IsZero() || p.MarkPrice.IsZero() {
+		return common.Decimal{}
+	}
+	return p.Amount.Mul(p.MarkPrice.Sub(p.EntryPrice)).Trim()
+}
@@ -0,0 +1,212 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type positionTrackerTestSuite struct {
+	baseTestSuite
+	tracker *PositionTracker
+	changes []TrackedPosition
+	errs    []error
+}
+
+func TestPositionTracker(t *testing.T) {
+	suite.Run(t, new(positionTrackerTestSuite))
+}
+
+func (s *positionTrackerTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.changes = nil
+	s.errs = nil
+	s.tracker = s.client.NewPositionTracker().
+		OnChange(func(p TrackedPosition) { s.changes = append(s.changes, p) }).
+		OnError(func(err error) { s.errs = append(s.errs, err) })
+}
+
+func (s *positionTrackerTestSuite) sync(data string) {
+	s.client.ExpectedCalls = nil
+	s.mockDo([]byte(data), nil)
+	defer s.assertDo()
+	s.r().NoError(s.tracker.Sync(newContext()))
+}
+
+func (s *positionTrackerTestSuite) position(symbol string, positionSide PositionSideType) TrackedPosition {
+	p, ok := s.tracker.Position(symbol, positionSide)
+	s.r().True(ok)
+	return p
+}
+
+func accountUpdate(transactionTime int64, positions ...WsPosition) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event:           UserDataEventTypeAccountUpdate,
+		TransactionTime: transactionTime,
+		AccountUpdate:   WsAccountUpdate{Reason: UserDataEventReasonTypeOrder, Positions: positions},
+	}
+}
+
+const positionTrackerSnapshot = `[
+	{"symbol": "BTCUSDT", "positionSide": "BOTH", "positionAmt": "1", "entryPrice": "100", "markPrice": "110", "marginType": "cross"},
+	{"symbol": "ETHUSDT", "positionSide": "BOTH", "positionAmt": "0", "entryPrice": "0", "markPrice": "2000", "marginType": "cross"}
+]`
+
+func (s *positionTrackerTestSuite) TestSync() {
+	s.tracker.Symbol("BTCUSDT")
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	s.sync(positionTrackerSnapshot)
+
+	p := s.position("BTCUSDT", PositionSideTypeBoth)
+	s.assertDecimal("1", p.Amount)
+	s.assertDecimal("100", p.EntryPrice)
+	s.assertDecimal("110", p.MarkPrice)
+	s.assertDecimal("10", p.UnrealizedPnL)
+	s.r().Equal(MarginType("cross"), p.MarginType)
+	s.r().Len(s.changes, 2)
+
+	positions := s.tracker.Positions()
+	s.r().Len(positions, 1)
+	s.r().Equal("BTCUSDT", positions[0].Symbol)
+
+	// an unchanged snapshot does not report anything
+	s.changes = nil
+	s.sync(positionTrackerSnapshot)
+	s.r().Empty(s.changes)
+}
+
+func (s *positionTrackerTestSuite) TestAccountUpdateAndMarkPrice() {
+	s.sync(positionTrackerSnapshot)
+	s.changes = nil
+	now := currentTimestamp()
+
+	s.tracker.HandleUserData(accountUpdate(now+1000, WsPosition{
+		Symbol: "BTCUSDT", Side: PositionSideTypeBoth, Amount: "2", EntryPrice: "105", MarginType: "cross",
+	}))
+	p := s.position("BTCUSDT", PositionSideTypeBoth)
+	s.assertDecimal("2", p.Amount)
+	s.assertDecimal("105", p.EntryPrice)
+	s.assertDecimal("10", p.UnrealizedPnL)
+	s.r().Equal(now+1000, p.UpdateTime)
+
+	s.tracker.HandleMarkPrice(&WsMarkPriceEvent{Symbol: "BTCUSDT", MarkPrice: "120"})
+	s.assertDecimal("30", s.position("BTCUSDT", PositionSideTypeBoth).UnrealizedPnL)
+	// a mark price of another symbol does not touch the position
+	s.tracker.HandleMarkPrice(&WsMarkPriceEvent{Symbol: "LTCUSDT", MarkPrice: "50"})
+	s.r().Len(s.changes, 2)
+
+	// an update older than the current state is ignored
+	s.tracker.HandleUserData(accountUpdate(now, WsPosition{
+		Symbol: "BTCUSDT", Side: PositionSideTypeBoth, Amount: "5", EntryPrice: "90",
+	}))
+	s.assertDecimal("2", s.position("BTCUSDT", PositionSideTypeBoth).Amount)
+	s.r().Len(s.changes, 2)
+
+	s.tracker.HandleUserData(accountUpdate(now+2000, WsPosition{
+		Symbol: "BTCUSDT", Side: PositionSideTypeBoth, Amount: "0", EntryPrice: "0",
+	}))
+	s.r().Empty(s.tracker.Positions())
+	s.assertDecimal("0", s.position("BTCUSDT", PositionSideTypeBoth).UnrealizedPnL)
+}
+
+func (s *positionTrackerTestSuite) TestHedgeMode() {
+	s.sync(`[
+		{"symbol": "BTCUSDT", "positionSide": "LONG", "positionAmt": "1", "entryPrice": "100", "markPrice": "90"},
+		{"symbol": "BTCUSDT", "positionSide": "SHORT", "positionAmt": "-2", "entryPrice": "95", "markPrice": "90"}
+	]`)
+	positions := s.tracker.SymbolPositions("BTCUSDT")
+	s.r().Len(positions, 2)
+	s.r().Equal(PositionSideTypeLong, positions[0].PositionSide)
+	s.assertDecimal("-10", positions[0].UnrealizedPnL)
+	s.r().Equal(PositionSideTypeShort, positions[1].PositionSide)
+	s.assertDecimal("10", positions[1].UnrealizedPnL)
+}
+
+func (s *positionTrackerTestSuite) TestRealizedPnL() {
+	s.sync(positionTrackerSnapshot)
+	s.changes = nil
+	for _, rp := range []string{"5.5", "0", "-1.25"} {
+		s.tracker.HandleUserData(&WsUserDataEvent{
+			Event: UserDataEventTypeOrderTradeUpdate,
+			OrderTradeUpdate: WsOrderTradeUpdate{
+				Symbol:       "BTCUSDT",
+				PositionSide: PositionSideTypeBoth,
+				RealizedPnL:  rp,
+			},
+		})
+	}
+	s.assertDecimal("4.25", s.position("BTCUSDT", PositionSideTypeBoth).RealizedPnL)
+	s.r().Len(s.changes, 2)
+
+	// a resync keeps the realised PnL
+	s.sync(positionTrackerSnapshot)
+	s.assertDecimal("4.25", s.position("BTCUSDT", PositionSideTypeBoth).RealizedPnL)
+}
+
+func (s *positionTrackerTestSuite) TestDuplicatedTrade() {
+	s.sync(positionTrackerSnapshot)
+	for _, tradeID := range []int64{101, 102, 101} {
+		s.tracker.HandleUserData(&WsUserDataEvent{
+			Event: UserDataEventTypeOrderTradeUpdate,
+			OrderTradeUpdate: WsOrderTradeUpdate{
+				Symbol:       "BTCUSDT",
+				PositionSide: PositionSideTypeBoth,
+				TradeID:      tradeID,
+				RealizedPnL:  "2",
+			},
+		})
+	}
+	s.assertDecimal("4", s.position("BTCUSDT", PositionSideTypeBoth).RealizedPnL)
+}
+
+func (s *positionTrackerTestSuite) TestUpdatesDuringSync() {
+	now := currentTimestamp()
+	s.assertReq(func(r *request) {
+		// events received while the snapshot is in flight
+		s.tracker.HandleUserData(accountUpdate(now-60000, WsPosition{
+			Symbol: "BTCUSDT", Side: PositionSideTypeBoth, Amount: "7", EntryPrice: "80",
+		}))
+		s.tracker.HandleUserData(accountUpdate(now+60000, WsPosition{
+			Symbol: "ETHUSDT", Side: PositionSideTypeBoth, Amount: "3", EntryPrice: "1900",
+		}))
+		s.r().Empty(s.changes)
+	})
+	s.sync(positionTrackerSnapshot)
+
+	// the older event is part of the snapshot already
+	s.assertDecimal("1", s.position("BTCUSDT", PositionSideTypeBoth).Amount)
+	eth := s.position("ETHUSDT", PositionSideTypeBoth)
+	s.assertDecimal("3", eth.Amount)
+	s.assertDecimal("300", eth.UnrealizedPnL)
+	s.r().Len(s.tracker.Positions(), 2)
+}
+
+func (s *positionTrackerTestSuite) TestSyncError() {
+	s.assertReq(func(r *request) {
+		s.tracker.HandleUserData(accountUpdate(currentTimestamp(), WsPosition{
+			Symbol: "BTCUSDT", Side: PositionSideTypeBoth, Amount: "1", EntryPrice: "100",
+		}))
+	})
+	s.mockDo([]byte(`{"code": -1022, "msg": "Signature for this request is not valid."}`), nil, 400)
+	s.r().Error(s.tracker.Sync(newContext()))
+	// without a snapshot the events received meanwhile are applied as is
+	s.assertDecimal("1", s.position("BTCUSDT", PositionSideTypeBoth).Amount)
+	s.r().Len(s.changes, 1)
+}
+
+func (s *positionTrackerTestSuite) TestInvalidEvent() {
+	s.sync(positionTrackerSnapshot)
+	s.tracker.HandleUserData(accountUpdate(currentTimestamp()+1000,
+		WsPosition{Symbol: "BTCUSDT", Side: PositionSideTypeBoth, Amount: "x"},
+		WsPosition{Symbol: "ETHUSDT", Side: PositionSideTypeBoth, Amount: "1", EntryPrice: "1000"},
+	))
+	s.r().Len(s.errs, 1)
+	s.assertDecimal("1", s.position("BTCUSDT", PositionSideTypeBoth).Amount)
+	s.assertDecimal("1", s.position("ETHUSDT", PositionSideTypeBoth).Amount)
+
+	s.tracker.HandleMarkPrice(&WsMarkPriceEvent{Symbol: "BTCUSDT", MarkPrice: "y"})
+	s.r().Len(s.errs, 2)
+}
@@ -0,0 +1,84 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// TakerBuySellVolumeService list the taker buy and sell volume of a symbol
+type TakerBuySellVolumeService struct {
+	c         *Client
+	symbol    string
+	period    PeriodType
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *TakerBuySellVolumeService) Symbol(symbol string) *TakerBuySellVolumeService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period interval
+func (s *TakerBuySellVolumeService) Period(period PeriodType) *TakerBuySellVolumeService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TakerBuySellVolumeService) Limit(limit int) *TakerBuySellVolumeService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TakerBuySellVolumeService) StartTime(startTime int64) *TakerBuySellVolumeService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TakerBuySellVolumeService) EndTime(endTime int64) *TakerBuySellVolumeService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TakerBuySellVolumeService) Do(ctx context.Context, opts ...RequestOption) (res []*TakerBuySellVolume, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/takerlongshortRatio",
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*TakerBuySellVolume{}, err
+	}
+	res = make([]*TakerBuySellVolume, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TakerBuySellVolume{}, err
+	}
+	return res, nil
+}
+
+// TakerBuySellVolume define taker buy and sell volume info
+type TakerBuySellVolume struct {
+	BuySellRatio string `json:"buySellRatio"`
+	BuyVol       string `json:"buyVol"`
+	SellVol      string `json:"sellVol"`
+	Timestamp    int64  `json:"timestamp"`
+}
@@ -0,0 +1,49 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type takerVolumeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTakerVolumeService(t *testing.T) {
+	suite.Run(t, new(takerVolumeServiceTestSuite))
+}
+
+func (s *takerVolumeServiceTestSuite) TestTakerBuySellVolume() {
+	data := []byte(`[
+		{
+			"buySellRatio": "1.5586",
+			"buyVol": "387.3300",
+			"sellVol": "248.5030",
+			"timestamp": 1585614900000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "BTCUSDT",
+			"period": Period5m,
+			"limit":  30,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewTakerBuySellVolumeService().Symbol("BTCUSDT").Period(Period5m).
+		Limit(30).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*TakerBuySellVolume{
+		{
+			BuySellRatio: "1.5586",
+			BuyVol:       "387.3300",
+			SellVol:      "248.5030",
+			Timestamp:    1585614900000,
+		},
+	}, res)
+}
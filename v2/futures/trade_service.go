@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // HistoricalTradesService trades
@@ -307,3 +309,28 @@ type AccountTrade struct {
 	Symbol          string           `json:"symbol"`
 	Time            int64            `json:"time"`
 }
+
+// PriceDecimal return Price as an exact decimal
+func (t *AccountTrade) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as an exact decimal
+func (t *AccountTrade) QuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as an exact decimal
+func (t *AccountTrade) QuoteQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.QuoteQuantity)
+}
+
+// CommissionDecimal return Commission as an exact decimal
+func (t *AccountTrade) CommissionDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Commission)
+}
+
+// RealizedPnlDecimal return RealizedPnl as an exact decimal
+func (t *AccountTrade) RealizedPnlDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.RealizedPnl)
+}
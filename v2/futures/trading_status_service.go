@@ -0,0 +1,113 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// GetADLQuantileService get the auto-deleveraging queue position of the
+// open positions
+type GetADLQuantileService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetADLQuantileService) Symbol(symbol string) *GetADLQuantileService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetADLQuantileService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolADLQuantile, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/adlQuantile",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*SymbolADLQuantile{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*SymbolADLQuantile, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*SymbolADLQuantile{}, err
+	}
+	return res, nil
+}
+
+// SymbolADLQuantile define the ADL quantiles of a symbol
+type SymbolADLQuantile struct {
+	Symbol      string      `json:"symbol"`
+	ADLQuantile ADLQuantile `json:"adlQuantile"`
+}
+
+// ADLQuantile define the ADL quantile of each position side, from 0 to 4,
+// the higher the sooner the position is deleveraged. In hedge mode Hedge is
+// the quantile of the side with the larger unrealized profit, in one-way
+// mode only Both is set.
+type ADLQuantile struct {
+	Long  int `json:"LONG"`
+	Short int `json:"SHORT"`
+	Hedge int `json:"HEDGE"`
+	Both  int `json:"BOTH"`
+}
+
+// GetAPITradingStatusService get the quantitative trading rules indicators
+// of the account
+type GetAPITradingStatusService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetAPITradingStatusService) Symbol(symbol string) *GetAPITradingStatusService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetAPITradingStatusService) Do(ctx context.Context, opts ...RequestOption) (res *APITradingStatus, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/apiTradingStatus",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(APITradingStatus)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// APITradingStatus define the trading rules indicators by symbol, the
+// account wide indicators are under "ACCOUNT"
+type APITradingStatus struct {
+	Indicators map[string][]*APITradingIndicator `json:"indicators"`
+	UpdateTime int64                             `json:"updateTime"`
+}
+
+// APITradingIndicator define a trading rules indicator
+type APITradingIndicator struct {
+	IsLocked           bool    `json:"isLocked"`
+	PlannedRecoverTime int64   `json:"plannedRecoverTime"`
+	Indicator          string  `json:"indicator"`
+	Value              float64 `json:"value"`
+	TriggerValue       float64 `json:"triggerValue"`
+}
@@ -0,0 +1,82 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type tradingStatusServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTradingStatusService(t *testing.T) {
+	suite.Run(t, new(tradingStatusServiceTestSuite))
+}
+
+func (s *tradingStatusServiceTestSuite) TestGetADLQuantile() {
+	data := []byte(`[
+		{
+			"symbol": "ETHUSDT",
+			"adlQuantile": {"LONG": 3, "SHORT": 3, "HEDGE": 0}
+		},
+		{
+			"symbol": "BTCUSDT",
+			"adlQuantile": {"LONG": 1, "BOTH": 0}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.NewGetADLQuantileService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*SymbolADLQuantile{
+		{Symbol: "ETHUSDT", ADLQuantile: ADLQuantile{Long: 3, Short: 3}},
+		{Symbol: "BTCUSDT", ADLQuantile: ADLQuantile{Long: 1}},
+	}, res)
+}
+
+func (s *tradingStatusServiceTestSuite) TestGetAPITradingStatus() {
+	data := []byte(`{
+		"indicators": {
+			"BTCUSDT": [
+				{
+					"isLocked": true,
+					"plannedRecoverTime": 1545741270000,
+					"indicator": "UFR",
+					"value": 0.05,
+					"triggerValue": 0.995
+				}
+			]
+		},
+		"updateTime": 1545741270000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetAPITradingStatusService().Symbol("BTCUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&APITradingStatus{
+		Indicators: map[string][]*APITradingIndicator{
+			"BTCUSDT": {
+				{
+					IsLocked:           true,
+					PlannedRecoverTime: 1545741270000,
+					Indicator:          "UFR",
+					Value:              0.05,
+					TriggerValue:       0.995,
+				},
+			},
+		},
+		UpdateTime: 1545741270000,
+	}, res)
+}
@@ -999,36 +999,39 @@ type WsPosition struct {
 
 // WsOrderTradeUpdate define order trade update
 type WsOrderTradeUpdate struct {
-	Symbol               string             `json:"s"`
-	ClientOrderID        string             `json:"c"`
-	Side                 SideType           `json:"S"`
-	Type                 OrderType          `json:"o"`
-	TimeInForce          TimeInForceType    `json:"f"`
-	OriginalQty          string             `json:"q"`
-	OriginalPrice        string             `json:"p"`
-	AveragePrice         string             `json:"ap"`
-	StopPrice            string             `json:"sp"`
-	ExecutionType        OrderExecutionType `json:"x"`
-	Status               OrderStatusType    `json:"X"`
-	ID                   int64              `json:"i"`
-	LastFilledQty        string             `json:"l"`
-	AccumulatedFilledQty string             `json:"z"`
-	LastFilledPrice      string             `json:"L"`
-	CommissionAsset      string             `json:"N"`
-	Commission           string             `json:"n"`
-	TradeTime            int64              `json:"T"`
-	TradeID              int64              `json:"t"`
-	BidsNotional         string             `json:"b"`
-	AsksNotional         string             `json:"a"`
-	IsMaker              bool               `json:"m"`
-	IsReduceOnly         bool               `json:"R"`
-	WorkingType          WorkingType        `json:"wt"`
-	OriginalType         OrderType          `json:"ot"`
-	PositionSide         PositionSideType   `json:"ps"`
-	IsClosingPosition    bool               `json:"cp"`
-	ActivationPrice      string             `json:"AP"`
-	CallbackRate         string             `json:"cr"`
-	RealizedPnL          string             `json:"rp"`
+	Symbol                  string             `json:"s"`
+	ClientOrderID           string             `json:"c"`
+	Side                    SideType           `json:"S"`
+	Type                    OrderType          `json:"o"`
+	TimeInForce             TimeInForceType    `json:"f"`
+	OriginalQty             string             `json:"q"`
+	OriginalPrice           string             `json:"p"`
+	AveragePrice            string             `json:"ap"`
+	StopPrice               string             `json:"sp"`
+	ExecutionType           OrderExecutionType `json:"x"`
+	Status                  OrderStatusType    `json:"X"`
+	ID                      int64              `json:"i"`
+	LastFilledQty           string             `json:"l"`
+	AccumulatedFilledQty    string             `json:"z"`
+	LastFilledPrice         string             `json:"L"`
+	CommissionAsset         string             `json:"N"`
+	Commission              string             `json:"n"`
+	TradeTime               int64              `json:"T"`
+	TradeID                 int64              `json:"t"`
+	BidsNotional            string             `json:"b"`
+	AsksNotional            string             `json:"a"`
+	IsMaker                 bool               `json:"m"`
+	IsReduceOnly            bool               `json:"R"`
+	WorkingType             WorkingType        `json:"wt"`
+	OriginalType            OrderType          `json:"ot"`
+	PositionSide            PositionSideType   `json:"ps"`
+	IsClosingPosition       bool               `json:"cp"`
+	ActivationPrice         string             `json:"AP"`
+	CallbackRate            string             `json:"cr"`
+	RealizedPnL             string             `json:"rp"`
+	SelfTradePreventionMode STPModeType        `json:"V"`
+	PriceMatch              PriceMatchType     `json:"pm"`
+	GoodTillDate            int64              `json:"gtd"`
 }
 
 // WsAccountConfigUpdate define account config update
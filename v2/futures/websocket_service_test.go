@@ -1847,6 +1847,97 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdateSelfTradePrevention() {
+	data := []byte(`{
+		"e":"ORDER_TRADE_UPDATE",
+		"E":1568879465651,
+		"T":1568879465650,
+		"o":{
+		  "s":"BTCUSDT",
+		  "c":"TEST",
+		  "S":"BUY",
+		  "o":"LIMIT",
+		  "f":"GTC",
+		  "q":"0.001",
+		  "p":"7000",
+		  "x":"EXPIRED",
+		  "X":"EXPIRED_IN_MATCH",
+		  "i":8886775,
+		  "T":1568879465651,
+		  "ps":"BOTH",
+		  "V":"EXPIRE_TAKER"
+		}
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "ORDER_TRADE_UPDATE",
+		Time:            1568879465651,
+		TransactionTime: 1568879465650,
+		OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol:                  "BTCUSDT",
+			ClientOrderID:           "TEST",
+			Side:                    "BUY",
+			Type:                    "LIMIT",
+			TimeInForce:             "GTC",
+			OriginalQty:             "0.001",
+			OriginalPrice:           "7000",
+			ExecutionType:           "EXPIRED",
+			Status:                  "EXPIRED_IN_MATCH",
+			ID:                      8886775,
+			TradeTime:               1568879465651,
+			PositionSide:            "BOTH",
+			SelfTradePreventionMode: STPModeExpireTaker,
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdateGoodTillDate() {
+	data := []byte(`{
+		"e":"ORDER_TRADE_UPDATE",
+		"E":1568879465651,
+		"T":1568879465650,
+		"o":{
+		  "s":"BTCUSDT",
+		  "c":"TEST",
+		  "S":"SELL",
+		  "o":"LIMIT",
+		  "f":"GTD",
+		  "q":"0.001",
+		  "p":"7500",
+		  "x":"NEW",
+		  "X":"NEW",
+		  "i":8886776,
+		  "T":1568879465651,
+		  "ps":"BOTH",
+		  "V":"EXPIRE_MAKER",
+		  "pm":"OPPONENT",
+		  "gtd":1568966400000
+		}
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "ORDER_TRADE_UPDATE",
+		Time:            1568879465651,
+		TransactionTime: 1568879465650,
+		OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol:                  "BTCUSDT",
+			ClientOrderID:           "TEST",
+			Side:                    "SELL",
+			Type:                    "LIMIT",
+			TimeInForce:             "GTD",
+			OriginalQty:             "0.001",
+			OriginalPrice:           "7500",
+			ExecutionType:           "NEW",
+			Status:                  "NEW",
+			ID:                      8886776,
+			TradeTime:               1568879465651,
+			PositionSide:            "BOTH",
+			SelfTradePreventionMode: STPModeExpireMaker,
+			PriceMatch:              PriceMatchOpponent,
+			GoodTillDate:            1568966400000,
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
 func (s *websocketServiceTestSuite) TestWsUserDataServeAccountConfigUpdate() {
 	data := []byte(`{
 		"e":"ACCOUNT_CONFIG_UPDATE",
@@ -1945,6 +2036,9 @@ func (s *websocketServiceTestSuite) assertOrderTradeUpdate(e, a WsOrderTradeUpda
 	r.Equal(e.ActivationPrice, a.ActivationPrice, "ActivationPrice")
 	r.Equal(e.CallbackRate, a.CallbackRate, "CallbackRate")
 	r.Equal(e.RealizedPnL, a.RealizedPnL, "RealizedPnL")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.PriceMatch, a.PriceMatch, "PriceMatch")
+	r.Equal(e.GoodTillDate, a.GoodTillDate, "GoodTillDate")
 }
 
 func (s *websocketServiceTestSuite) assertAccountConfigUpdate(e, a WsAccountConfigUpdate) {
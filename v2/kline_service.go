@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // KlinesService list klines
@@ -67,6 +69,85 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	if err != nil {
 		return []*Kline{}, err
 	}
+	return parseKlines(data)
+}
+
+// UIKlinesService list klines modified for presentation of candlestick charts
+type UIKlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+	timeZone  *string
+}
+
+// Symbol set symbol
+func (s *UIKlinesService) Symbol(symbol string) *UIKlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *UIKlinesService) Interval(interval string) *UIKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *UIKlinesService) Limit(limit int) *UIKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *UIKlinesService) StartTime(startTime int64) *UIKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *UIKlinesService) EndTime(endTime int64) *UIKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// TimeZone set the time zone used to interpret the intervals, as hours and
+// minutes (e.g. "-1:00", "05:45") or hours only (e.g. "0", "8"). Defaults to 0 (UTC).
+func (s *UIKlinesService) TimeZone(timeZone string) *UIKlinesService {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Do send request
+func (s *UIKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/uiKlines",
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("interval", s.interval)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.timeZone != nil {
+		r.setParam("timeZone", *s.timeZone)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return parseKlines(data)
+}
+
+func parseKlines(data []byte) (res []*Kline, err error) {
 	j, err := newJSON(data)
 	if err != nil {
 		return []*Kline{}, err
@@ -110,3 +191,33 @@ type Kline struct {
 	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
 	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
 }
+
+// OpenDecimal return Open as an exact decimal
+func (k *Kline) OpenDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(k.Open)
+}
+
+// HighDecimal return High as an exact decimal
+func (k *Kline) HighDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(k.High)
+}
+
+// LowDecimal return Low as an exact decimal
+func (k *Kline) LowDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(k.Low)
+}
+
+// CloseDecimal return Close as an exact decimal
+func (k *Kline) CloseDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(k.Close)
+}
+
+// VolumeDecimal return Volume as an exact decimal
+func (k *Kline) VolumeDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal return QuoteAssetVolume as an exact decimal
+func (k *Kline) QuoteAssetVolumeDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(k.QuoteAssetVolume)
+}
@@ -0,0 +1,513 @@
+// Package ledger replay the trades of an account into lots to compute the
+// realised and unrealised PnL per asset, matching the disposals with the
+// FIFO, LIFO or average cost method.
+//
+// A book holds the lots of an asset bought with a quote asset, e.g. BTC
+// bought with USDT, and its PnL is in the quote asset. With a reporting
+// currency the spot, margin and convert lots of an asset are held in a
+// single book valued in that currency instead, so that BTC bought with USDT
+// and sold for ETH is matched, and the ETH received opens a lot of its own. The trades of the
+// spot, margin and USDⓈ-M futures accounts and the convert history are
+// turned into ledger trades with SpotTrades, MarginTrades, FuturesTrades
+// and ConvertTrades.
+package ledger
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Method define how the disposals are matched against the lots
+type Method string
+
+// Side define side of a trade
+type Side string
+
+// Source define the account or service a trade comes from
+type Source string
+
+// Global enums
+const (
+	MethodFIFO        Method = "FIFO"
+	MethodLIFO        Method = "LIFO"
+	MethodAverageCost Method = "AVERAGE_COST"
+
+	SideBuy  Side = "BUY"
+	SideSell Side = "SELL"
+
+	SourceSpot    Source = "SPOT"
+	SourceMargin  Source = "MARGIN"
+	SourceFutures Source = "FUTURES"
+	SourceConvert Source = "CONVERT"
+
+	// pricePlaces is the precision of the per unit prices including fees
+	pricePlaces = 18
+)
+
+// Trade define a trade of Quantity of Asset at Price in QuoteAsset
+type Trade struct {
+	Source Source
+	ID     string
+	// Time is in milliseconds
+	Time       int64
+	Asset      string
+	QuoteAsset string
+	// PositionSide is LONG or SHORT for the futures trades in hedge mode,
+	// each side being booked separately
+	PositionSide    string
+	Side            Side
+	Quantity        common.Decimal
+	Price           common.Decimal
+	Commission      common.Decimal
+	CommissionAsset string
+}
+
+// PriceFunc return the price of asset in quoteAsset at time, in milliseconds
+type PriceFunc func(asset, quoteAsset string, time int64) (price common.Decimal, ok bool)
+
+// FeeBookFunc return the quote assets of the books of the commission asset
+// the third asset commission of t is paid from, in order. It return none if
+// the commission is not paid from booked holdings.
+type FeeBookFunc func(t Trade) (quoteAssets []string)
+
+// Lot define an open quantity of an asset and its price, fees included
+type Lot struct {
+	Source  Source
+	TradeID string
+	Time    int64
+	// Quantity is negative for a short lot
+	Quantity common.Decimal
+	Price    common.Decimal
+}
+
+// Disposal define the quantity of a lot closed by a trade
+type Disposal struct {
+	OpenSource   Source
+	OpenTradeID  string
+	OpenTime     int64
+	OpenPrice    common.Decimal
+	CloseSource  Source
+	CloseTradeID string
+	CloseTime    int64
+	ClosePrice   common.Decimal
+	// Quantity is negative when a short lot is covered
+	Quantity    common.Decimal
+	CostBasis   common.Decimal
+	Proceeds    common.Decimal
+	RealizedPnL common.Decimal
+}
+
+// Book define the lots of an asset bought with a quote asset
+type Book struct {
+	Asset        string
+	QuoteAsset   string
+	PositionSide string
+	Lots         []Lot
+	Disposals    []Disposal
+	RealizedPnL  common.Decimal
+	// Fees is the commission in the quote asset, including the commission
+	// in a third asset valued with the fee price function
+	Fees common.Decimal
+	// UnvaluedFees is the commission in a third asset that could not be valued
+	UnvaluedFees map[string]common.Decimal
+}
+
+// Position return the open quantity, negative when short
+func (b *Book) Position() common.Decimal {
+	var position common.Decimal
+	for _, lot := range b.Lots {
+		position = position.Add(lot.Quantity)
+	}
+	return position
+}
+
+// UnrealizedPnL return the profit of closing the open lots at price
+func (b *Book) UnrealizedPnL(price common.Decimal) common.Decimal {
+	var pnl common.Decimal
+	for _, lot := range b.Lots {
+		pnl = pnl.Add(lot.Quantity.Mul(price.Sub(lot.Price)))
+	}
+	return pnl.Trim()
+}
+
+type bookKey struct {
+	asset, quoteAsset, positionSide string
+}
+
+// Ledger collect trades and replay them into books
+type Ledger struct {
+	method   Method
+	feePrice PriceFunc
+	feeBooks FeeBookFunc
+	currency string
+	price    PriceFunc
+	trades   []Trade
+	seen     map[string]struct{}
+}
+
+// New init a ledger matching the disposals with method
+func New(method Method) *Ledger {
+	return &Ledger{method: method, seen: make(map[string]struct{})}
+}
+
+// FeePrice set the function valuing the commission paid in a third asset,
+// such as BNB, in the quote asset of the trade
+func (l *Ledger) FeePrice(f PriceFunc) *Ledger {
+	l.feePrice = f
+	return l
+}
+
+// FeeBooks set the function picking the books a third asset commission is
+// paid from. By default a futures commission is paid from the futures
+// wallet, which is not booked, and the other commissions from every book of
+// the commission asset, the one of the trade quote asset first. The books
+// are not split by account: the spot and margin holdings of the commission
+// asset are pooled, pick the books explicitly to tell them apart.
+func (l *Ledger) FeeBooks(f FeeBookFunc) *Ledger {
+	l.feeBooks = f
+	return l
+}
+
+// ReportingCurrency book the spot, margin and convert trades in currency,
+// price returning the price of an asset in currency. Each trade then
+// acquires one asset and disposes of the other, e.g. buying BTC with ETH
+// opens a BTC lot and closes ETH lots, both valued at the ETH price in
+// currency. The lots of an asset are matched whatever the quote asset of
+// the trades, and the commission in a third asset is paid from its book.
+// The futures trades stay booked in their quote asset.
+//
+// The spot and margin trades share the books of an asset.
+func (l *Ledger) ReportingCurrency(currency string, price PriceFunc) *Ledger {
+	l.currency = currency
+	l.price = price
+	return l
+}
+
+// Add add trades, the trades already added with the same source and id are ignored
+func (l *Ledger) Add(trades ...Trade) {
+	for _, t := range trades {
+		key := string(t.Source) + "/" + t.ID
+		if _, ok := l.seen[key]; ok {
+			continue
+		}
+		l.seen[key] = struct{}{}
+		l.trades = append(l.trades, t)
+	}
+}
+
+// Books replay the trades by time and return the books by asset, quote
+// asset and position side
+func (l *Ledger) Books() ([]*Book, error) {
+	switch l.method {
+	case MethodFIFO, MethodLIFO, MethodAverageCost:
+	default:
+		return nil, fmt.Errorf("ledger: invalid method %q", l.method)
+	}
+	trades := make([]Trade, len(l.trades))
+	copy(trades, l.trades)
+	sort.SliceStable(trades, func(i, j int) bool { return trades[i].Time < trades[j].Time })
+
+	r := &replay{
+		method:   l.method,
+		feePrice: l.feePrice,
+		feeBooks: l.feeBooks,
+		currency: l.currency,
+		price:    l.price,
+		books:    make(map[bookKey]*Book),
+	}
+	for _, t := range trades {
+		if err := r.apply(t); err != nil {
+			return nil, err
+		}
+	}
+	books := make([]*Book, 0, len(r.books))
+	for _, b := range r.books {
+		b.RealizedPnL = b.RealizedPnL.Trim()
+		b.Fees = b.Fees.Trim()
+		books = append(books, b)
+	}
+	sort.Slice(books, func(i, j int) bool {
+		a, b := books[i], books[j]
+		if a.Asset != b.Asset {
+			return a.Asset < b.Asset
+		}
+		if a.QuoteAsset != b.QuoteAsset {
+			return a.QuoteAsset < b.QuoteAsset
+		}
+		return a.PositionSide < b.PositionSide
+	})
+	return books, nil
+}
+
+type replay struct {
+	method   Method
+	feePrice PriceFunc
+	feeBooks FeeBookFunc
+	currency string
+	price    PriceFunc
+	books    map[bookKey]*Book
+}
+
+func (r *replay) book(asset, quoteAsset, positionSide string) *Book {
+	key := bookKey{asset, quoteAsset, positionSide}
+	b, ok := r.books[key]
+	if !ok {
+		b = &Book{
+			Asset:        asset,
+			QuoteAsset:   quoteAsset,
+			PositionSide: positionSide,
+			UnvaluedFees: make(map[string]common.Decimal),
+		}
+		r.books[key] = b
+	}
+	return b
+}
+
+func (r *replay) apply(t Trade) error {
+	if t.Side != SideBuy && t.Side != SideSell {
+		return fmt.Errorf("ledger: trade %s/%s: invalid side %q", t.Source, t.ID, t.Side)
+	}
+	if !t.Quantity.IsPositive() {
+		return fmt.Errorf("ledger: trade %s/%s: invalid quantity %s", t.Source, t.ID, t.Quantity)
+	}
+	if r.currency != "" && t.Source != SourceFutures {
+		return r.applyReporting(t)
+	}
+	b := r.book(t.Asset, t.QuoteAsset, t.PositionSide)
+	// the quantity bought or sold once the commission in the asset is paid
+	net := t.Quantity
+	var feeQuote common.Decimal
+	if !t.Commission.IsZero() {
+		switch t.CommissionAsset {
+		case t.Asset:
+			if t.Side == SideBuy {
+				net = net.Sub(t.Commission)
+			} else {
+				net = net.Add(t.Commission)
+			}
+		case t.QuoteAsset:
+			feeQuote = t.Commission
+		default:
+			feeQuote = r.thirdAssetFee(b, t)
+		}
+	}
+	b.Fees = b.Fees.Add(feeQuote)
+	if !net.IsPositive() {
+		return nil
+	}
+	// the price per unit of the net quantity, fees included
+	value := t.Quantity.Mul(t.Price)
+	if t.Side == SideBuy {
+		value = value.Add(feeQuote)
+	} else {
+		value = value.Sub(feeQuote)
+		net = net.Neg()
+	}
+	price := value.Div(net.Abs(), pricePlaces, common.RoundHalfEven).Trim()
+	r.fill(b, t.Source, t.ID, t.Time, net, price)
+	return nil
+}
+
+// thirdAssetFee value the commission in the quote asset and take it out of
+// the book of the commission asset, it return zero if it cannot be valued
+func (r *replay) thirdAssetFee(b *Book, t Trade) common.Decimal {
+	var price common.Decimal
+	ok := false
+	if r.feePrice != nil {
+		price, ok = r.feePrice(t.CommissionAsset, t.QuoteAsset, t.Time)
+	}
+	if !ok {
+		b.UnvaluedFees[t.CommissionAsset] = b.UnvaluedFees[t.CommissionAsset].Add(t.Commission)
+		return common.Decimal{}
+	}
+	// spending the commission asset is a disposal of it
+	remaining := t.Commission
+	for _, quoteAsset := range r.feeQuoteAssets(t) {
+		feeBook, exists := r.books[bookKey{t.CommissionAsset, quoteAsset, ""}]
+		if !exists {
+			continue
+		}
+		quantity := common.MinDecimal(remaining, feeBook.Position())
+		if !quantity.IsPositive() {
+			continue
+		}
+		bookPrice := price
+		if quoteAsset != t.QuoteAsset {
+			var valued bool
+			if bookPrice, valued = r.feePrice(t.CommissionAsset, quoteAsset, t.Time); !valued {
+				continue
+			}
+		}
+		r.fill(feeBook, t.Source, t.ID, t.Time, quantity.Neg(), bookPrice)
+		if remaining = remaining.Sub(quantity); !remaining.IsPositive() {
+			break
+		}
+	}
+	return t.Commission.Mul(price)
+}
+
+// feeQuoteAssets return the quote assets of the books the third asset
+// commission of t is paid from
+func (r *replay) feeQuoteAssets(t Trade) []string {
+	if r.feeBooks != nil {
+		return r.feeBooks(t)
+	}
+	if t.Source == SourceFutures {
+		return nil
+	}
+	quoteAssets := []string{t.QuoteAsset}
+	var others []string
+	for key := range r.books {
+		if key.asset == t.CommissionAsset && key.positionSide == "" && key.quoteAsset != t.QuoteAsset {
+			others = append(others, key.quoteAsset)
+		}
+	}
+	sort.Strings(others)
+	return append(quoteAssets, others...)
+}
+
+// rate return the price of asset in the reporting currency at time
+func (r *replay) rate(asset string, time int64) (common.Decimal, bool) {
+	if asset == r.currency {
+		return common.NewDecimalFromInt(1), true
+	}
+	if r.price == nil {
+		return common.Decimal{}, false
+	}
+	return r.price(asset, r.currency, time)
+}
+
+// applyReporting book a trade in the reporting currency: it acquires the
+// base asset and disposes of the quote asset when buying, the other way
+// round when selling
+func (r *replay) applyReporting(t Trade) error {
+	quoteRate, ok := r.rate(t.QuoteAsset, t.Time)
+	if !ok {
+		return fmt.Errorf("ledger: trade %s/%s: no %s price in %s", t.Source, t.ID, t.QuoteAsset, r.currency)
+	}
+	b := r.book(t.Asset, r.currency, "")
+	// the base quantity bought or sold, and the quote quantity paid or
+	// received, once the commission is paid
+	net := t.Quantity
+	quote := t.Quantity.Mul(t.Price)
+	// fee is the commission in the reporting currency, thirdFee the part of
+	// it not already in the quote quantity
+	var fee, thirdFee common.Decimal
+	if !t.Commission.IsZero() {
+		switch t.CommissionAsset {
+		case t.Asset:
+			if t.Side == SideBuy {
+				net = net.Sub(t.Commission)
+			} else {
+				net = net.Add(t.Commission)
+			}
+		case t.QuoteAsset:
+			if t.Side == SideBuy {
+				quote = quote.Add(t.Commission)
+			} else {
+				quote = quote.Sub(t.Commission)
+			}
+			fee = t.Commission.Mul(quoteRate)
+		default:
+			fee = r.reportingFee(b, t)
+			thirdFee = fee
+		}
+	}
+	b.Fees = b.Fees.Add(fee)
+	value := quote.Mul(quoteRate)
+	if t.Side == SideBuy {
+		value = value.Add(thirdFee)
+	} else {
+		value = value.Sub(thirdFee)
+	}
+	if net.IsPositive() {
+		price := value.Div(net, pricePlaces, common.RoundHalfEven).Trim()
+		if t.Side == SideSell {
+			net = net.Neg()
+		}
+		r.fill(b, t.Source, t.ID, t.Time, net, price)
+	}
+	if t.QuoteAsset == r.currency || !quote.IsPositive() {
+		return nil
+	}
+	// the other leg of the trade
+	if t.Side == SideBuy {
+		quote = quote.Neg()
+	}
+	r.fill(r.book(t.QuoteAsset, r.currency, ""), t.Source, t.ID, t.Time, quote, quoteRate)
+	return nil
+}
+
+// reportingFee value the commission in a third asset in the reporting
+// currency and take it out of the book of the commission asset, it return
+// zero if it cannot be valued
+func (r *replay) reportingFee(b *Book, t Trade) common.Decimal {
+	price, ok := r.rate(t.CommissionAsset, t.Time)
+	if !ok {
+		b.UnvaluedFees[t.CommissionAsset] = b.UnvaluedFees[t.CommissionAsset].Add(t.Commission)
+		return common.Decimal{}
+	}
+	if feeBook, exists := r.books[bookKey{t.CommissionAsset, r.currency, ""}]; exists {
+		quantity := common.MinDecimal(t.Commission, feeBook.Position())
+		if quantity.IsPositive() {
+			r.fill(feeBook, t.Source, t.ID, t.Time, quantity.Neg(), price)
+		}
+	}
+	return t.Commission.Mul(price)
+}
+
+// fill apply a signed quantity at price to the book, closing the lots of
+// the other side first
+func (r *replay) fill(b *Book, source Source, tradeID string, time int64, quantity, price common.Decimal) {
+	for !quantity.IsZero() && len(b.Lots) > 0 {
+		i := 0
+		if r.method == MethodLIFO {
+			i = len(b.Lots) - 1
+		}
+		lot := &b.Lots[i]
+		if lot.Quantity.Sign() == quantity.Sign() {
+			break
+		}
+		// the signed quantity of the lot closed
+		closed := lot.Quantity
+		if closed.Abs().GreaterThan(quantity.Abs()) {
+			closed = quantity.Neg()
+		}
+		d := Disposal{
+			OpenSource:   lot.Source,
+			OpenTradeID:  lot.TradeID,
+			OpenTime:     lot.Time,
+			OpenPrice:    lot.Price,
+			CloseSource:  source,
+			CloseTradeID: tradeID,
+			CloseTime:    time,
+			ClosePrice:   price,
+			Quantity:     closed,
+			CostBasis:    closed.Mul(lot.Price).Trim(),
+			Proceeds:     closed.Mul(price).Trim(),
+			RealizedPnL:  closed.Mul(price.Sub(lot.Price)).Trim(),
+		}
+		b.Disposals = append(b.Disposals, d)
+		b.RealizedPnL = b.RealizedPnL.Add(d.RealizedPnL)
+		lot.Quantity = lot.Quantity.Sub(closed)
+		quantity = quantity.Add(closed)
+		if lot.Quantity.IsZero() {
+			b.Lots = append(b.Lots[:i], b.Lots[i+1:]...)
+		}
+	}
+	if quantity.IsZero() {
+		return
+	}
+	if r.method == MethodAverageCost && len(b.Lots) == 1 {
+		// pool the lots at their average price
+		lot := &b.Lots[0]
+		total := lot.Quantity.Add(quantity)
+		lot.Price = lot.Quantity.Mul(lot.Price).Add(quantity.Mul(price)).
+			Div(total, pricePlaces, common.RoundHalfEven).Trim()
+		lot.Quantity = total
+		return
+	}
+	b.Lots = append(b.Lots, Lot{Source: source, TradeID: tradeID, Time: time, Quantity: quantity, Price: price})
+}
@@ -0,0 +1,356 @@
+package ledger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type baseTestSuite struct {
+	suite.Suite
+}
+
+func (s *baseTestSuite) assertDecimal(e string, a common.Decimal) {
+	s.Require().True(dec(e).Equal(a), "expected %s, got %s", e, a)
+}
+
+type ledgerTestSuite struct {
+	baseTestSuite
+}
+
+func TestLedger(t *testing.T) {
+	suite.Run(t, new(ledgerTestSuite))
+}
+
+func dec(s string) common.Decimal {
+	return common.MustParseDecimal(s)
+}
+
+func spotTrade(id string, time int64, side Side, quantity, price string) Trade {
+	return Trade{
+		Source:     SourceSpot,
+		ID:         id,
+		Time:       time,
+		Asset:      "BTC",
+		QuoteAsset: "USDT",
+		Side:       side,
+		Quantity:   dec(quantity),
+		Price:      dec(price),
+	}
+}
+
+func (s *ledgerTestSuite) books(l *Ledger) []*Book {
+	books, err := l.Books()
+	s.Require().NoError(err)
+	return books
+}
+
+func (s *ledgerTestSuite) book(l *Ledger) *Book {
+	books := s.books(l)
+	s.Require().Len(books, 1)
+	return books[0]
+}
+
+func (s *ledgerTestSuite) addRoundTrip(l *Ledger) {
+	// added out of order, the trades are replayed by time
+	l.Add(
+		spotTrade("3", 3000, SideSell, "1.5", "300"),
+		spotTrade("1", 1000, SideBuy, "1", "100"),
+		spotTrade("2", 2000, SideBuy, "1", "200"),
+	)
+}
+
+func (s *ledgerTestSuite) TestFIFO() {
+	l := New(MethodFIFO)
+	s.addRoundTrip(l)
+	b := s.book(l)
+	s.Require().Len(b.Disposals, 2)
+	d := b.Disposals[0]
+	s.Require().Equal("1", d.OpenTradeID)
+	s.Require().Equal("3", d.CloseTradeID)
+	s.Require().Equal(int64(3000), d.CloseTime)
+	s.assertDecimal("1", d.Quantity)
+	s.assertDecimal("100", d.CostBasis)
+	s.assertDecimal("300", d.Proceeds)
+	s.assertDecimal("200", d.RealizedPnL)
+	s.Require().Equal("2", b.Disposals[1].OpenTradeID)
+	s.assertDecimal("0.5", b.Disposals[1].Quantity)
+	s.assertDecimal("50", b.Disposals[1].RealizedPnL)
+	s.assertDecimal("250", b.RealizedPnL)
+
+	s.Require().Len(b.Lots, 1)
+	s.Require().Equal("2", b.Lots[0].TradeID)
+	s.assertDecimal("0.5", b.Position())
+	s.assertDecimal("200", b.Lots[0].Price)
+	s.assertDecimal("50", b.UnrealizedPnL(dec("300")))
+}
+
+func (s *ledgerTestSuite) TestLIFO() {
+	l := New(MethodLIFO)
+	s.addRoundTrip(l)
+	b := s.book(l)
+	s.Require().Len(b.Disposals, 2)
+	s.Require().Equal("2", b.Disposals[0].OpenTradeID)
+	s.assertDecimal("100", b.Disposals[0].RealizedPnL)
+	s.Require().Equal("1", b.Disposals[1].OpenTradeID)
+	s.assertDecimal("100", b.Disposals[1].RealizedPnL)
+	s.assertDecimal("200", b.RealizedPnL)
+	s.Require().Len(b.Lots, 1)
+	s.Require().Equal("1", b.Lots[0].TradeID)
+	s.assertDecimal("0.5", b.Lots[0].Quantity)
+}
+
+func (s *ledgerTestSuite) TestAverageCost() {
+	l := New(MethodAverageCost)
+	s.addRoundTrip(l)
+	b := s.book(l)
+	s.Require().Len(b.Disposals, 1)
+	s.assertDecimal("150", b.Disposals[0].OpenPrice)
+	s.assertDecimal("225", b.RealizedPnL)
+	s.Require().Len(b.Lots, 1)
+	s.assertDecimal("0.5", b.Lots[0].Quantity)
+	s.assertDecimal("150", b.Lots[0].Price)
+}
+
+func (s *ledgerTestSuite) TestCommission() {
+	buy := spotTrade("1", 1000, SideBuy, "1", "99.9")
+	buy.Commission, buy.CommissionAsset = dec("0.001"), "BTC"
+	sell := spotTrade("2", 2000, SideSell, "0.999", "300")
+	sell.Commission, sell.CommissionAsset = dec("0.2997"), "USDT"
+
+	l := New(MethodFIFO)
+	l.Add(buy, sell)
+	b := s.book(l)
+	s.Require().Empty(b.Lots)
+	s.Require().Len(b.Disposals, 1)
+	d := b.Disposals[0]
+	// the commission in BTC raises the cost of the BTC received
+	s.assertDecimal("0.999", d.Quantity)
+	s.assertDecimal("100", d.OpenPrice)
+	s.assertDecimal("99.9", d.CostBasis)
+	// the commission in USDT lowers the proceeds
+	s.assertDecimal("299.4003", d.Proceeds)
+	s.assertDecimal("199.5003", b.RealizedPnL)
+	s.assertDecimal("0.2997", b.Fees)
+}
+
+func (s *ledgerTestSuite) TestThirdAssetCommission() {
+	bnb := Trade{
+		Source: SourceSpot, ID: "1", Time: 1000, Asset: "BNB", QuoteAsset: "USDT",
+		Side: SideBuy, Quantity: dec("10"), Price: dec("10"),
+	}
+	buy := spotTrade("2", 2000, SideBuy, "1", "100")
+	buy.Commission, buy.CommissionAsset = dec("0.1"), "BNB"
+
+	var asked []string
+	l := New(MethodFIFO).FeePrice(func(asset, quoteAsset string, time int64) (common.Decimal, bool) {
+		asked = append(asked, asset+quoteAsset)
+		s.Require().Equal(int64(2000), time)
+		return dec("20"), true
+	})
+	l.Add(bnb, buy)
+	books := s.books(l)
+	s.Require().Len(books, 2)
+	s.Require().Equal([]string{"BNBUSDT"}, asked)
+
+	bnbBook, btcBook := books[0], books[1]
+	s.Require().Equal("BNB", bnbBook.Asset)
+	// paying the fee disposes of BNB at its price then
+	s.Require().Len(bnbBook.Disposals, 1)
+	s.Require().Equal("2", bnbBook.Disposals[0].CloseTradeID)
+	s.assertDecimal("0.1", bnbBook.Disposals[0].Quantity)
+	s.assertDecimal("1", bnbBook.RealizedPnL)
+	s.assertDecimal("9.9", bnbBook.Position())
+
+	s.assertDecimal("2", btcBook.Fees)
+	s.assertDecimal("102", btcBook.Lots[0].Price)
+	s.Require().Empty(btcBook.UnvaluedFees)
+}
+
+func (s *ledgerTestSuite) TestThirdAssetCommissionBooks() {
+	bnb := Trade{
+		Source: SourceSpot, ID: "1", Time: 1000, Asset: "BNB", QuoteAsset: "BTC",
+		Side: SideBuy, Quantity: dec("1"), Price: dec("0.01"),
+	}
+	buy := spotTrade("2", 2000, SideBuy, "1", "100")
+	buy.Commission, buy.CommissionAsset = dec("0.1"), "BNB"
+	future := spotTrade("3", 3000, SideBuy, "1", "100")
+	future.Source = SourceFutures
+	future.Commission, future.CommissionAsset = dec("0.1"), "BNB"
+
+	l := New(MethodFIFO).FeePrice(func(asset, quoteAsset string, time int64) (common.Decimal, bool) {
+		if quoteAsset == "BTC" {
+			return dec("0.02"), true
+		}
+		return dec("20"), true
+	})
+	l.Add(bnb, buy, future)
+	books := s.books(l)
+	s.Require().Len(books, 2)
+
+	// the BNB bought with BTC pays the spot fee, valued in BTC
+	bnbBook, btcBook := books[0], books[1]
+	s.Require().Equal("BTC", bnbBook.QuoteAsset)
+	s.Require().Len(bnbBook.Disposals, 1)
+	s.Require().Equal("2", bnbBook.Disposals[0].CloseTradeID)
+	s.assertDecimal("0.02", bnbBook.Disposals[0].ClosePrice)
+	// the futures fee is paid from the futures wallet
+	s.assertDecimal("0.9", bnbBook.Position())
+	s.assertDecimal("4", btcBook.Fees)
+
+	// the caller picks the books instead
+	l = New(MethodFIFO).FeePrice(func(asset, quoteAsset string, time int64) (common.Decimal, bool) {
+		return dec("0.02"), true
+	}).FeeBooks(func(t Trade) []string {
+		if t.Source == SourceFutures {
+			return []string{"BTC"}
+		}
+		return nil
+	})
+	l.Add(bnb, buy, future)
+	bnbBook = s.books(l)[0]
+	s.Require().Len(bnbBook.Disposals, 1)
+	s.Require().Equal("3", bnbBook.Disposals[0].CloseTradeID)
+}
+
+func (s *ledgerTestSuite) TestReportingCurrency() {
+	sell := Trade{
+		Source: SourceMargin, ID: "2", Time: 2000, Asset: "BTC", QuoteAsset: "ETH",
+		Side: SideSell, Quantity: dec("1"), Price: dec("0.05"),
+		Commission: dec("0.1"), CommissionAsset: "BNB",
+	}
+	ethSell := Trade{
+		Source: SourceSpot, ID: "3", Time: 3000, Asset: "ETH", QuoteAsset: "USDT",
+		Side: SideSell, Quantity: dec("0.05"), Price: dec("3000"),
+		Commission: dec("1.5"), CommissionAsset: "USDT",
+	}
+	bnb := Trade{
+		Source: SourceSpot, ID: "0", Time: 1500, Asset: "BNB", QuoteAsset: "BTC",
+		Side: SideBuy, Quantity: dec("1"), Price: dec("0.002"),
+	}
+	prices := map[string]string{"ETH@2000": "2500", "BTC@1500": "100", "BNB@2000": "10"}
+	l := New(MethodFIFO).ReportingCurrency("USDT", func(asset, quoteAsset string, time int64) (common.Decimal, bool) {
+		s.Require().Equal("USDT", quoteAsset)
+		price, ok := prices[fmt.Sprintf("%s@%d", asset, time)]
+		if !ok {
+			return common.Decimal{}, false
+		}
+		return dec(price), true
+	})
+	l.Add(spotTrade("1", 1000, SideBuy, "1.002", "100"), bnb, sell, ethSell)
+	books := s.books(l)
+	// the USDT spent and received is the reporting currency, not booked
+	s.Require().Len(books, 3)
+	for _, b := range books {
+		s.Equal("USDT", b.QuoteAsset)
+	}
+	bnbBook, btcBook, ethBook := books[0], books[1], books[2]
+
+	// the BNB bought with BTC disposes of 0.002 BTC and pays the fee
+	s.Require().Equal("BNB", bnbBook.Asset)
+	s.assertDecimal("0.9", bnbBook.Position())
+	s.assertDecimal("0.2", bnbBook.Lots[0].Price)
+	s.assertDecimal("0.98", bnbBook.RealizedPnL)
+
+	// the BTC bought with USDT is closed by the sale for ETH
+	s.Require().Equal("BTC", btcBook.Asset)
+	s.assertDecimal("0", btcBook.Position())
+	s.Require().Len(btcBook.Disposals, 2)
+	s.assertDecimal("124", btcBook.Disposals[1].ClosePrice)
+	s.assertDecimal("24", btcBook.RealizedPnL)
+	s.assertDecimal("1", btcBook.Fees)
+
+	// the ETH received opens a lot at its price, sold later for USDT
+	s.Require().Equal("ETH", ethBook.Asset)
+	s.Require().Len(ethBook.Disposals, 1)
+	s.assertDecimal("2500", ethBook.Disposals[0].OpenPrice)
+	s.assertDecimal("23.5", ethBook.RealizedPnL)
+	s.assertDecimal("1.5", ethBook.Fees)
+
+	// a trade that cannot be valued fails the replay
+	l.Add(Trade{
+		Source: SourceSpot, ID: "4", Time: 4000, Asset: "BTC", QuoteAsset: "FDUSD",
+		Side: SideBuy, Quantity: dec("1"), Price: dec("100"),
+	})
+	_, err := l.Books()
+	s.Error(err)
+}
+
+func (s *ledgerTestSuite) TestUnvaluedCommission() {
+	buy := spotTrade("1", 1000, SideBuy, "1", "100")
+	buy.Commission, buy.CommissionAsset = dec("0.1"), "BNB"
+	l := New(MethodFIFO).FeePrice(func(asset, quoteAsset string, time int64) (common.Decimal, bool) {
+		return common.Decimal{}, false
+	})
+	l.Add(buy)
+	b := s.book(l)
+	s.Require().True(b.Fees.IsZero())
+	s.assertDecimal("0.1", b.UnvaluedFees["BNB"])
+	s.assertDecimal("100", b.Lots[0].Price)
+}
+
+func (s *ledgerTestSuite) TestShort() {
+	short := func(id string, time int64, side Side, quantity, price string) Trade {
+		return Trade{
+			Source: SourceFutures, ID: id, Time: time, Asset: "BTCUSDT", QuoteAsset: "USDT",
+			PositionSide: "SHORT", Side: side, Quantity: dec(quantity), Price: dec(price),
+		}
+	}
+	l := New(MethodFIFO)
+	l.Add(
+		short("1", 1000, SideSell, "2", "100"),
+		short("2", 2000, SideBuy, "1", "90"),
+	)
+	b := s.book(l)
+	s.Require().Equal("SHORT", b.PositionSide)
+	s.Require().Len(b.Disposals, 1)
+	s.assertDecimal("-1", b.Disposals[0].Quantity)
+	s.assertDecimal("10", b.RealizedPnL)
+	s.assertDecimal("-1", b.Position())
+	s.assertDecimal("20", b.UnrealizedPnL(dec("80")))
+}
+
+func (s *ledgerTestSuite) TestReverse() {
+	l := New(MethodFIFO)
+	l.Add(
+		spotTrade("1", 1000, SideBuy, "1", "100"),
+		spotTrade("2", 2000, SideSell, "3", "110"),
+	)
+	b := s.book(l)
+	s.assertDecimal("10", b.RealizedPnL)
+	// the rest of the sell opens a short lot
+	s.Require().Len(b.Lots, 1)
+	s.Require().Equal("2", b.Lots[0].TradeID)
+	s.assertDecimal("-2", b.Lots[0].Quantity)
+	s.assertDecimal("110", b.Lots[0].Price)
+}
+
+func (s *ledgerTestSuite) TestAdd() {
+	l := New(MethodFIFO)
+	trade := spotTrade("1", 1000, SideBuy, "1", "100")
+	l.Add(trade, trade)
+	l.Add(trade)
+	margin := trade
+	margin.Source = SourceMargin
+	l.Add(margin)
+	b := s.book(l)
+	s.Require().Len(b.Lots, 2)
+	s.assertDecimal("2", b.Position())
+}
+
+func (s *ledgerTestSuite) TestInvalid() {
+	_, err := New(Method("HIFO")).Books()
+	s.Require().Error(err)
+
+	l := New(MethodFIFO)
+	l.Add(spotTrade("1", 1000, Side("HOLD"), "1", "100"))
+	_, err = l.Books()
+	s.Require().Error(err)
+
+	l = New(MethodFIFO)
+	l.Add(spotTrade("1", 1000, SideBuy, "0", "100"))
+	_, err = l.Books()
+	s.Require().Error(err)
+}
@@ -0,0 +1,62 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"io"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ReportHeader is the header of the lot report
+var ReportHeader = []string{
+	"asset", "quote_asset", "position_side", "status", "quantity",
+	"open_source", "open_trade_id", "open_time", "open_price",
+	"close_source", "close_trade_id", "close_time", "close_price",
+	"cost_basis", "proceeds", "realized_pnl", "unrealized_pnl",
+}
+
+// WriteCSV write a lot report of the books, one CLOSED row per disposal and
+// one OPEN row per open lot. The unrealised PnL of the open lots is filled in
+// for the books with a mark price, by asset and quote asset.
+func WriteCSV(w io.Writer, books []*Book, marks map[Pair]common.Decimal) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ReportHeader); err != nil {
+		return err
+	}
+	for _, b := range books {
+		for _, d := range b.Disposals {
+			err := cw.Write([]string{
+				b.Asset, b.QuoteAsset, b.PositionSide, "CLOSED", d.Quantity.String(),
+				string(d.OpenSource), d.OpenTradeID, formatTime(d.OpenTime), d.OpenPrice.String(),
+				string(d.CloseSource), d.CloseTradeID, formatTime(d.CloseTime), d.ClosePrice.String(),
+				d.CostBasis.String(), d.Proceeds.String(), d.RealizedPnL.String(), "",
+			})
+			if err != nil {
+				return err
+			}
+		}
+		mark, marked := marks[Pair{Base: b.Asset, Quote: b.QuoteAsset}]
+		for _, lot := range b.Lots {
+			unrealized := ""
+			if marked {
+				unrealized = lot.Quantity.Mul(mark.Sub(lot.Price)).Trim().String()
+			}
+			err := cw.Write([]string{
+				b.Asset, b.QuoteAsset, b.PositionSide, "OPEN", lot.Quantity.String(),
+				string(lot.Source), lot.TradeID, formatTime(lot.Time), lot.Price.String(),
+				"", "", "", "",
+				lot.Quantity.Mul(lot.Price).Trim().String(), "", "", unrealized,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatTime(ms int64) string {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC().Format("2006-01-02T15:04:05.000Z")
+}
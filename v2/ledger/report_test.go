@@ -0,0 +1,51 @@
+package ledger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type reportTestSuite struct {
+	suite.Suite
+}
+
+func TestReport(t *testing.T) {
+	suite.Run(t, new(reportTestSuite))
+}
+
+func (s *reportTestSuite) books() []*Book {
+	l := New(MethodFIFO)
+	l.Add(
+		spotTrade("1", 1000, SideBuy, "1", "100"),
+		spotTrade("2", 2000, SideBuy, "1", "200"),
+		spotTrade("3", 60000, SideSell, "1.5", "300"),
+	)
+	books, err := l.Books()
+	s.Require().NoError(err)
+	return books
+}
+
+func (s *reportTestSuite) TestWriteCSV() {
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, s.books(), map[Pair]common.Decimal{{Base: "BTC", Quote: "USDT"}: dec("250")})
+	s.Require().NoError(err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	s.Require().Equal([]string{
+		strings.Join(ReportHeader, ","),
+		"BTC,USDT,,CLOSED,1,SPOT,1,1970-01-01T00:00:01.000Z,100,SPOT,3,1970-01-01T00:01:00.000Z,300,100,300,200,",
+		"BTC,USDT,,CLOSED,0.5,SPOT,2,1970-01-01T00:00:02.000Z,200,SPOT,3,1970-01-01T00:01:00.000Z,300,100,150,50,",
+		"BTC,USDT,,OPEN,0.5,SPOT,2,1970-01-01T00:00:02.000Z,200,,,,,100,,,25",
+	}, lines)
+}
+
+func (s *reportTestSuite) TestWriteCSVWithoutMark() {
+	var buf bytes.Buffer
+	s.Require().NoError(WriteCSV(&buf, s.books(), nil))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	s.Require().Len(lines, 4)
+	s.Require().True(strings.HasSuffix(lines[3], ",100,,,"), lines[3])
+}
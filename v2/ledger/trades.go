@@ -0,0 +1,173 @@
+package ledger
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// Pair define the base and quote assets of a symbol
+type Pair struct {
+	Base  string
+	Quote string
+}
+
+// Pairs define the pairs by symbol
+type Pairs map[string]Pair
+
+// PairsFromExchangeInfo return the pairs of the spot symbols
+func PairsFromExchangeInfo(info *binance.ExchangeInfo) Pairs {
+	pairs := make(Pairs, len(info.Symbols))
+	for _, s := range info.Symbols {
+		pairs[s.Symbol] = Pair{Base: s.BaseAsset, Quote: s.QuoteAsset}
+	}
+	return pairs
+}
+
+// PairsFromFuturesExchangeInfo return the pairs of the USDⓈ-M futures symbols
+func PairsFromFuturesExchangeInfo(info *futures.ExchangeInfo) Pairs {
+	pairs := make(Pairs, len(info.Symbols))
+	for _, s := range info.Symbols {
+		pairs[s.Symbol] = Pair{Base: s.BaseAsset, Quote: s.QuoteAsset}
+	}
+	return pairs
+}
+
+func (p Pairs) lookup(symbol string) (Pair, error) {
+	pair, ok := p[symbol]
+	if !ok {
+		return Pair{}, fmt.Errorf("ledger: unknown symbol %s", symbol)
+	}
+	return pair, nil
+}
+
+func parseDecimals(fields ...string) ([]common.Decimal, error) {
+	values := make([]common.Decimal, len(fields))
+	for i, s := range fields {
+		if s == "" {
+			continue
+		}
+		d, err := common.ParseDecimal(s)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = d
+	}
+	return values, nil
+}
+
+func accountTrades(source Source, trades []*binance.TradeV3, pairs Pairs) ([]Trade, error) {
+	res := make([]Trade, 0, len(trades))
+	for _, t := range trades {
+		pair, err := pairs.lookup(t.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		values, err := parseDecimals(t.Quantity, t.Price, t.Commission)
+		if err != nil {
+			return nil, err
+		}
+		side := SideSell
+		if t.IsBuyer {
+			side = SideBuy
+		}
+		res = append(res, Trade{
+			Source:          source,
+			ID:              t.Symbol + "-" + strconv.FormatInt(t.ID, 10),
+			Time:            t.Time,
+			Asset:           pair.Base,
+			QuoteAsset:      pair.Quote,
+			Side:            side,
+			Quantity:        values[0],
+			Price:           values[1],
+			Commission:      values[2],
+			CommissionAsset: t.CommissionAsset,
+		})
+	}
+	return res, nil
+}
+
+// SpotTrades convert the trades of ListTradesService
+func SpotTrades(trades []*binance.TradeV3, pairs Pairs) ([]Trade, error) {
+	return accountTrades(SourceSpot, trades, pairs)
+}
+
+// MarginTrades convert the trades of ListMarginTradesService
+func MarginTrades(trades []*binance.TradeV3, pairs Pairs) ([]Trade, error) {
+	return accountTrades(SourceMargin, trades, pairs)
+}
+
+// FuturesTrades convert the trades of futures ListAccountTradeService. The
+// asset of a futures trade is its contract symbol, so that the futures
+// positions are booked apart from the spot balances.
+func FuturesTrades(trades []*futures.AccountTrade, pairs Pairs) ([]Trade, error) {
+	res := make([]Trade, 0, len(trades))
+	for _, t := range trades {
+		pair, err := pairs.lookup(t.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		values, err := parseDecimals(t.Quantity, t.Price, t.Commission)
+		if err != nil {
+			return nil, err
+		}
+		trade := Trade{
+			Source:          SourceFutures,
+			ID:              t.Symbol + "-" + strconv.FormatInt(t.ID, 10),
+			Time:            t.Time,
+			Asset:           t.Symbol,
+			QuoteAsset:      pair.Quote,
+			Side:            Side(t.Side),
+			Quantity:        values[0],
+			Price:           values[1],
+			Commission:      values[2],
+			CommissionAsset: t.CommissionAsset,
+		}
+		if t.PositionSide == futures.PositionSideTypeLong || t.PositionSide == futures.PositionSideTypeShort {
+			trade.PositionSide = string(t.PositionSide)
+		}
+		res = append(res, trade)
+	}
+	return res, nil
+}
+
+// ConvertTrades convert the successful trades of ConvertTradeHistoryService.
+// A conversion is a buy of the base asset of the pair of its two assets, or
+// a buy of the asset received with the asset given if they are not a pair.
+func ConvertTrades(items []binance.ConvertTradeHistoryItem, pairs Pairs) ([]Trade, error) {
+	res := make([]Trade, 0, len(items))
+	for _, item := range items {
+		if item.OrderStatus != "SUCCESS" {
+			continue
+		}
+		values, err := parseDecimals(item.FromAmount, item.ToAmount)
+		if err != nil {
+			return nil, err
+		}
+		from, to := values[0], values[1]
+		if !from.IsPositive() || !to.IsPositive() {
+			return nil, fmt.Errorf("ledger: convert %d: invalid amounts", item.OrderId)
+		}
+		trade := Trade{
+			Source:     SourceConvert,
+			ID:         strconv.FormatInt(item.OrderId, 10),
+			Time:       item.CreateTime,
+			Asset:      item.ToAsset,
+			QuoteAsset: item.FromAsset,
+			Side:       SideBuy,
+			Quantity:   to,
+			Price:      from.Div(to, pricePlaces, common.RoundHalfEven).Trim(),
+		}
+		if pair, ok := pairs[item.FromAsset+item.ToAsset]; ok && pair.Base == item.FromAsset {
+			// selling the base asset for the quote asset
+			trade.Asset, trade.QuoteAsset, trade.Side = item.FromAsset, item.ToAsset, SideSell
+			trade.Quantity = from
+			trade.Price = to.Div(from, pricePlaces, common.RoundHalfEven).Trim()
+		}
+		res = append(res, trade)
+	}
+	return res, nil
+}
@@ -0,0 +1,129 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/suite"
+)
+
+type tradesTestSuite struct {
+	baseTestSuite
+	pairs Pairs
+}
+
+func TestTrades(t *testing.T) {
+	suite.Run(t, new(tradesTestSuite))
+}
+
+func (s *tradesTestSuite) SetupTest() {
+	s.pairs = PairsFromExchangeInfo(&binance.ExchangeInfo{Symbols: []binance.Symbol{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"},
+		{Symbol: "BNBBTC", BaseAsset: "BNB", QuoteAsset: "BTC"},
+	}})
+}
+
+func (s *tradesTestSuite) TestSpotTrades() {
+	trades, err := SpotTrades([]*binance.TradeV3{
+		{ID: 1, Symbol: "BTCUSDT", Price: "100", Quantity: "0.5", Commission: "0.0005",
+			CommissionAsset: "BTC", Time: 1000, IsBuyer: true},
+		{ID: 2, Symbol: "BNBBTC", Price: "0.01", Quantity: "3", Commission: "0",
+			CommissionAsset: "BNB", Time: 2000},
+	}, s.pairs)
+	s.Require().NoError(err)
+	s.Require().Len(trades, 2)
+	t := trades[0]
+	s.Require().Equal(SourceSpot, t.Source)
+	s.Require().Equal("BTCUSDT-1", t.ID)
+	s.Require().Equal(int64(1000), t.Time)
+	s.Require().Equal("BTC", t.Asset)
+	s.Require().Equal("USDT", t.QuoteAsset)
+	s.Require().Equal(SideBuy, t.Side)
+	s.assertDecimal("0.5", t.Quantity)
+	s.assertDecimal("100", t.Price)
+	s.assertDecimal("0.0005", t.Commission)
+	s.Require().Equal("BTC", t.CommissionAsset)
+
+	s.Require().Equal("BNB", trades[1].Asset)
+	s.Require().Equal("BTC", trades[1].QuoteAsset)
+	s.Require().Equal(SideSell, trades[1].Side)
+
+	_, err = SpotTrades([]*binance.TradeV3{{ID: 3, Symbol: "ETHUSDT", Price: "1", Quantity: "1"}}, s.pairs)
+	s.Require().Error(err)
+	_, err = SpotTrades([]*binance.TradeV3{{ID: 3, Symbol: "BTCUSDT", Price: "x", Quantity: "1"}}, s.pairs)
+	s.Require().Error(err)
+}
+
+func (s *tradesTestSuite) TestMarginTrades() {
+	trades, err := MarginTrades([]*binance.TradeV3{
+		{ID: 1, Symbol: "BTCUSDT", Price: "100", Quantity: "0.5", IsBuyer: true},
+	}, s.pairs)
+	s.Require().NoError(err)
+	s.Require().Len(trades, 1)
+	s.Require().Equal(SourceMargin, trades[0].Source)
+	s.Require().True(trades[0].Commission.IsZero())
+}
+
+func (s *tradesTestSuite) TestFuturesTrades() {
+	pairs := PairsFromFuturesExchangeInfo(&futures.ExchangeInfo{Symbols: []futures.Symbol{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"},
+	}})
+	trades, err := FuturesTrades([]*futures.AccountTrade{
+		{ID: 1, Symbol: "BTCUSDT", Side: futures.SideTypeSell, PositionSide: futures.PositionSideTypeShort,
+			Price: "100", Quantity: "2", Commission: "0.08", CommissionAsset: "USDT", Time: 1000},
+		{ID: 2, Symbol: "BTCUSDT", Side: futures.SideTypeBuy, PositionSide: futures.PositionSideTypeBoth,
+			Price: "90", Quantity: "1", Commission: "0.036", CommissionAsset: "USDT", Time: 2000},
+	}, pairs)
+	s.Require().NoError(err)
+	s.Require().Len(trades, 2)
+	t := trades[0]
+	s.Require().Equal(SourceFutures, t.Source)
+	s.Require().Equal("BTCUSDT-1", t.ID)
+	s.Require().Equal("BTCUSDT", t.Asset)
+	s.Require().Equal("USDT", t.QuoteAsset)
+	s.Require().Equal("SHORT", t.PositionSide)
+	s.Require().Equal(SideSell, t.Side)
+	s.assertDecimal("0.08", t.Commission)
+	// one-way mode positions are booked together
+	s.Require().Equal("", trades[1].PositionSide)
+	s.Require().Equal(SideBuy, trades[1].Side)
+
+	_, err = FuturesTrades([]*futures.AccountTrade{{ID: 3, Symbol: "ETHUSDT"}}, pairs)
+	s.Require().Error(err)
+}
+
+func (s *tradesTestSuite) TestConvertTrades() {
+	trades, err := ConvertTrades([]binance.ConvertTradeHistoryItem{
+		{OrderId: 1, OrderStatus: "SUCCESS", FromAsset: "USDT", FromAmount: "200",
+			ToAsset: "BTC", ToAmount: "0.5", CreateTime: 1000},
+		{OrderId: 2, OrderStatus: "SUCCESS", FromAsset: "BTC", FromAmount: "0.1",
+			ToAsset: "USDT", ToAmount: "45", CreateTime: 2000},
+		{OrderId: 3, OrderStatus: "PROCESS", FromAsset: "BTC", FromAmount: "0.1",
+			ToAsset: "USDT", ToAmount: "45", CreateTime: 3000},
+	}, s.pairs)
+	s.Require().NoError(err)
+	s.Require().Len(trades, 2)
+
+	buy := trades[0]
+	s.Require().Equal(SourceConvert, buy.Source)
+	s.Require().Equal("1", buy.ID)
+	s.Require().Equal(int64(1000), buy.Time)
+	s.Require().Equal("BTC", buy.Asset)
+	s.Require().Equal("USDT", buy.QuoteAsset)
+	s.Require().Equal(SideBuy, buy.Side)
+	s.assertDecimal("0.5", buy.Quantity)
+	s.assertDecimal("400", buy.Price)
+
+	sell := trades[1]
+	s.Require().Equal("BTC", sell.Asset)
+	s.Require().Equal("USDT", sell.QuoteAsset)
+	s.Require().Equal(SideSell, sell.Side)
+	s.assertDecimal("0.1", sell.Quantity)
+	s.assertDecimal("450", sell.Price)
+
+	_, err = ConvertTrades([]binance.ConvertTradeHistoryItem{
+		{OrderId: 4, OrderStatus: "SUCCESS", FromAsset: "USDT", FromAmount: "0", ToAsset: "BTC", ToAmount: "1"},
+	}, s.pairs)
+	s.Require().Error(err)
+}
@@ -3,24 +3,29 @@ package binance
 import (
 	"context"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateMarginOrderService create order
 type CreateMarginOrderService struct {
-	c                *Client
-	symbol           string
-	side             SideType
-	orderType        OrderType
-	quantity         *string
-	quoteOrderQty    *string
-	price            *string
-	stopPrice        *string
-	newClientOrderID *string
-	icebergQuantity  *string
-	newOrderRespType *NewOrderRespType
-	sideEffectType   *SideEffectType
-	timeInForce      *TimeInForceType
-	isIsolated       *bool
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	stopPrice               *string
+	newClientOrderID        *string
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+	sideEffectType          *SideEffectType
+	timeInForce             *TimeInForceType
+	isIsolated              *bool
+	selfTradePreventionMode *STPModeType
+	validateSymbol          *Symbol
+	validateOpts            []ValidateOption
 }
 
 // Symbol set symbol
@@ -101,8 +106,45 @@ func (s *CreateMarginOrderService) SideEffectType(sideEffectType SideEffectType)
 	return s
 }
 
+// SelfTradePreventionMode set selfTradePreventionMode. Margin orders don't
+// take a strategy id or type.
+func (s *CreateMarginOrderService) SelfTradePreventionMode(mode STPModeType) *CreateMarginOrderService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateMarginOrderService) QuantityDecimal(d common.Decimal) *CreateMarginOrderService {
+	return s.Quantity(d.String())
+}
+
+// QuoteOrderQtyDecimal set quoteOrderQty from a decimal
+func (s *CreateMarginOrderService) QuoteOrderQtyDecimal(d common.Decimal) *CreateMarginOrderService {
+	return s.QuoteOrderQty(d.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateMarginOrderService) PriceDecimal(d common.Decimal) *CreateMarginOrderService {
+	return s.Price(d.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateMarginOrderService) StopPriceDecimal(d common.Decimal) *CreateMarginOrderService {
+	return s.StopPrice(d.String())
+}
+
+// IcebergQuantityDecimal set icebergQuantity from a decimal
+func (s *CreateMarginOrderService) IcebergQuantityDecimal(d common.Decimal) *CreateMarginOrderService {
+	return s.IcebergQuantity(d.String())
+}
+
 // Do send request
 func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	if s.validateSymbol != nil {
+		if err = s.Validate(s.validateSymbol, s.validateOpts...); err != nil {
+			return nil, err
+		}
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/sapi/v1/margin/order",
@@ -147,6 +189,9 @@ func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...RequestOption
 	if s.sideEffectType != nil {
 		m["sideEffectType"] = *s.sideEffectType
 	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
 	r.setFormParams(m)
 	res = new(CreateOrderResponse)
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -250,6 +250,8 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
+
+	apiErrorHooks common.APIErrorHooks
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -358,6 +360,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if e != nil {
 			c.debug("failed to unmarshal json: %s", e)
 		}
+		c.apiErrorHooks.Call(apiErr)
 		return nil, &http.Header{}, apiErr
 	}
 	return data, &res.Header, nil
@@ -384,6 +387,12 @@ func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
 }
 
+// NewExchangeInfoRegistry init exchange info registry, the cached exchange info
+// is refreshed by lookups once it is older than ttl
+func (c *Client) NewExchangeInfoRegistry(ttl time.Duration) *ExchangeInfoRegistry {
+	return newExchangeInfoRegistry(c, ttl)
+}
+
 // NewCreateOrderService init creating order service
 func (c *Client) NewCreateOrderService() *CreateOrderService {
 	return &CreateOrderService{c: c}
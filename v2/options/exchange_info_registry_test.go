@@ -0,0 +1,79 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type exchangeInfoRegistryTestSuite struct {
+	baseTestSuite
+}
+
+func TestExchangeInfoRegistry(t *testing.T) {
+	suite.Run(t, new(exchangeInfoRegistryTestSuite))
+}
+
+const registryExchangeInfo = `{
+	"timezone": "UTC",
+	"serverTime": 1592387337630,
+	"optionContracts": [
+		{"id": 1, "baseAsset": "BTC", "quoteAsset": "USDT", "underlying": "BTCUSDT", "settleAsset": "USDT"},
+		{"id": 2, "baseAsset": "ETH", "quoteAsset": "USDT", "underlying": "ETHUSDT", "settleAsset": "USDT"}
+	],
+	"optionSymbols": [
+		{
+			"contractId": 1,
+			"expiryDate": 1660521600000,
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "0.02", "maxPrice": "80000.01", "tickSize": "0.01"},
+				{"filterType": "LOT_SIZE", "minQty": "0.01", "maxQty": "100", "stepSize": "0.01"}
+			],
+			"id": 17,
+			"symbol": "BTC-220815-50000-C",
+			"status": "TRADING",
+			"side": "CALL",
+			"strikePrice": "50000",
+			"underlying": "BTCUSDT",
+			"unit": 1,
+			"quoteAsset": "USDT"
+		},
+		{
+			"contractId": 2,
+			"expiryDate": 1660521600000,
+			"filters": [],
+			"id": 18,
+			"symbol": "ETH-220815-2000-P",
+			"status": "TRADING",
+			"side": "PUT",
+			"strikePrice": "2000",
+			"underlying": "ETHUSDT",
+			"unit": 1,
+			"quoteAsset": "USDT"
+		}
+	]
+}`
+
+func (s *exchangeInfoRegistryTestSuite) TestRegistry() {
+	s.mockDo([]byte(registryExchangeInfo), nil)
+	registry := s.client.NewExchangeInfoRegistry(0)
+	r := s.r()
+
+	symbol, err := registry.Symbol(newContext(), "BTC-220815-50000-C")
+	r.NoError(err)
+	r.Equal("BTC", symbol.BaseAsset)
+	r.Equal("0.02", symbol.Price.MinPrice)
+	r.Equal("12.34", symbol.QuantizePrice(common.MustParseDecimal("12.345"), common.RoundDown).String())
+	r.Equal("1.24", symbol.QuantizeQuantity(common.MustParseDecimal("1.235"), common.RoundUp).String())
+
+	eth := registry.SymbolsByBaseAsset("ETH")
+	r.Len(eth, 1)
+	r.Equal("ETH-220815-2000-P", eth[0].Symbol)
+	r.Nil(eth[0].Price)
+	r.Len(registry.SymbolsByQuoteAsset("USDT"), 2)
+	r.Len(registry.SymbolsByStatus(SymbolStatusTypeTrading), 2)
+	r.Len(registry.Symbols(), 2)
+	r.False(registry.ObserveError(&common.APIError{Code: -2011}))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
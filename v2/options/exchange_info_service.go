@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ExchangeInfoService exchange info service
@@ -71,6 +74,7 @@ type OptionSymbol struct {
 	Filters              []map[string]interface{} `json:"filters"`
 	Id                   int64                    `json:"id"`
 	Symbol               string                   `json:"symbol"`
+	Status               string                   `json:"status"`
 	Side                 string                   `json:"side"`
 	StrikePrice          string                   `json:"strikePrice"`
 	Underlying           string                   `json:"underlying"`
@@ -88,6 +92,31 @@ type OptionSymbol struct {
 	QuoteAsset           string                   `json:"quoteAsset"`
 }
 
+// OptionSide return Side as an option side type
+func (s *OptionSymbol) OptionSide() OptionSideType {
+	return OptionSideType(s.Side)
+}
+
+// StrikePriceDecimal return StrikePrice as an exact decimal
+func (s *OptionSymbol) StrikePriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(s.StrikePrice)
+}
+
+// MinQuantityDecimal return MinQty as an exact decimal
+func (s *OptionSymbol) MinQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(s.MinQty)
+}
+
+// MaxQuantityDecimal return MaxQty as an exact decimal
+func (s *OptionSymbol) MaxQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(s.MaxQty)
+}
+
+// ExpiryTime return ExpiryDate, in milliseconds, as a time
+func (s *OptionSymbol) ExpiryTime() time.Time {
+	return time.UnixMilli(s.ExpiryDate)
+}
+
 // LotSizeFilter define lot size filter of symbol
 type LotSizeFilter struct {
 	MaxQuantity string `json:"maxQty"`
@@ -2,7 +2,9 @@ package options
 
 import (
 	"testing"
+	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -169,6 +171,75 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 	s.assertLotSizeFilterEqual(eLotSizeFilter, res.OptionSymbols[0].LotSizeFilter())
 }
 
+func (s *exchangeInfoServiceTestSuite) TestExchangeInfoContractFields() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1695889187654,
+		"optionContracts": [
+			{"baseAsset": "BTC", "quoteAsset": "USDT", "underlying": "BTCUSDT", "settleAsset": "USDT"}
+		],
+		"optionAssets": [
+			{"name": "USDT"}
+		],
+		"optionSymbols": [
+			{
+				"expiryDate": 1696060800000,
+				"filters": [
+					{"filterType": "PRICE_FILTER", "minPrice": "5", "maxPrice": "5570", "tickSize": "5"},
+					{"filterType": "LOT_SIZE", "minQty": "0.01", "maxQty": "1200", "stepSize": "0.01"}
+				],
+				"symbol": "BTC-230930-27000-P",
+				"side": "PUT",
+				"strikePrice": "27000.00000000",
+				"underlying": "BTCUSDT",
+				"unit": 1,
+				"makerFeeRate": "0.00020000",
+				"takerFeeRate": "0.00020000",
+				"minQty": "0.01",
+				"maxQty": "1200",
+				"initialMargin": "0.15000000",
+				"maintenanceMargin": "0.07500000",
+				"minInitialMargin": "0.10000000",
+				"minMaintenanceMargin": "0.05000000",
+				"priceScale": 0,
+				"quantityScale": 2,
+				"quoteAsset": "USDT",
+				"status": "TRADING"
+			}
+		],
+		"rateLimits": [
+			{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 2400}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	res, err := s.client.NewExchangeInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.OptionSymbols, 1)
+
+	symbol := res.OptionSymbols[0]
+	r.Equal(OptionSideTypePut, symbol.OptionSide())
+	r.Equal(string(SymbolStatusTypeTrading), symbol.Status)
+	r.Equal(int64(1), symbol.Unit)
+	r.Equal(int64(1696060800000), symbol.ExpiryTime().UnixMilli())
+	r.Equal("2023-09-30T08:00:00Z", symbol.ExpiryTime().UTC().Format(time.RFC3339))
+
+	strike, err := symbol.StrikePriceDecimal()
+	r.NoError(err)
+	r.True(strike.Equal(common.NewDecimalFromInt(27000)))
+	minQty, err := symbol.MinQuantityDecimal()
+	r.NoError(err)
+	r.Equal("0.01", minQty.String())
+	maxQty, err := symbol.MaxQuantityDecimal()
+	r.NoError(err)
+	r.Equal("1200", maxQty.String())
+
+	s.assertPriceFilterEqual(&PriceFilter{MinPrice: "5", MaxPrice: "5570", TickSize: "5"}, symbol.PriceFilter())
+	s.assertLotSizeFilterEqual(&LotSizeFilter{MinQuantity: "0.01", MaxQuantity: "1200", StepSize: "0.01"}, symbol.LotSizeFilter())
+	r.Equal(symbol.MinQty, symbol.LotSizeFilter().MinQuantity)
+}
+
 func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInfo) {
 	r := s.r()
 
@@ -196,6 +267,7 @@ func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInf
 		r.Equal(e.OptionSymbols[i].ExpiryDate, a.OptionSymbols[i].ExpiryDate, "ExpiryDate")
 		r.Equal(e.OptionSymbols[i].Id, a.OptionSymbols[i].Id, "Id")
 		r.Equal(e.OptionSymbols[i].Symbol, a.OptionSymbols[i].Symbol, "Symbol")
+		r.Equal(e.OptionSymbols[i].Status, a.OptionSymbols[i].Status, "Status")
 		r.Equal(e.OptionSymbols[i].Side, a.OptionSymbols[i].Side, "Side")
 		r.Equal(e.OptionSymbols[i].StrikePrice, a.OptionSymbols[i].StrikePrice, "StrikePrice")
 		r.Equal(e.OptionSymbols[i].Underlying, a.OptionSymbols[i].Underlying, "Underlying")
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateOrderService create order
@@ -504,13 +506,29 @@ func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOp
 	return res, nil
 }
 
+// maxBatchOrders is the maximum number of orders of a batchOrders request
+const maxBatchOrders = 10
+
+// CreateBatchOrdersService create multiple orders, the orders are sent in
+// batches of 10, the maximum allowed per request
 type CreateBatchOrdersService struct {
 	c      *Client
 	orders []*CreateOrderService
 }
 
+// CreateBatchOrdersResponse define create batch orders response
 type CreateBatchOrdersResponse struct {
+	// Orders hold the created orders, the failed ones are skipped
 	Orders []*SingleOrder
+	// Results hold one result per order, in the order of OrderList
+	Results []*BatchOrderResult
+}
+
+// BatchOrderResult define the result of one order of a batch request,
+// exactly one of Order and Error is set
+type BatchOrderResult struct {
+	Order *SingleOrder
+	Error *common.APIError
 }
 
 // SingleOrder define single order info returned by batch create request
@@ -527,93 +545,119 @@ type SingleOrder struct {
 	Mmp           bool      `json:"mmp"`
 }
 
+// OrderList set the orders to create
 func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
 	s.orders = orders
 	return s
 }
 
+// Do send request. The failure of an order is reported in its result. If a
+// request fails, the results of the batches sent before it are returned
+// along with the error.
 func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	res = new(CreateBatchOrdersResponse)
+	for start := 0; start < len(s.orders); start += maxBatchOrders {
+		end := start + maxBatchOrders
+		if end > len(s.orders) {
+			end = len(s.orders)
+		}
+		results, err := s.createBatch(ctx, s.orders[start:end], opts...)
+		if err != nil {
+			return res, err
+		}
+		for _, result := range results {
+			res.Results = append(res.Results, result)
+			if result.Order != nil {
+				res.Orders = append(res.Orders, result.Order)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *CreateBatchOrdersService) createBatch(ctx context.Context, batch []*CreateOrderService, opts ...RequestOption) ([]*BatchOrderResult, error) {
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/eapi/v1/batchOrders",
 		secType:  secTypeSigned,
 	}
-
 	orders := []params{}
-	for _, order := range s.orders {
-		m := params{
-			"symbol":           order.symbol,
-			"side":             order.side,
-			"type":             order.orderType,
-			"quantity":         order.quantity,
-			"newOrderRespType": order.newOrderRespType,
-		}
-
-		if order.timeInForce != nil {
-			m["timeInForce"] = *order.timeInForce
-		}
-		if order.reduceOnly != nil {
-			m["reduceOnly"] = *order.reduceOnly
-		}
-		if order.postOnly != nil {
-			m["postOnly"] = *order.postOnly
-		}
-		if order.price != nil {
-			m["price"] = *order.price
-		}
-		if order.clientOrderID != nil {
-			m["clientOrderId"] = *order.clientOrderID
-		}
-		if order.isMmp != nil {
-			m["isMmp"] = *order.isMmp
-		}
-		orders = append(orders, m)
+	for _, order := range batch {
+		orders = append(orders, order.batchParams())
 	}
 	b, err := json.Marshal(orders)
 	if err != nil {
-		return &CreateBatchOrdersResponse{}, err
+		return nil, err
 	}
-	m := params{
+	r.setFormParams(params{
 		"batchOrders": string(b),
-	}
-
-	r.setFormParams(m)
-
+	})
 	data, _, err := s.c.callAPI(ctx, r, opts...)
-
 	if err != nil {
-		return &CreateBatchOrdersResponse{}, err
+		return nil, err
 	}
+	return parseBatchOrderResults(data)
+}
 
-	rawMessages := make([]*json.RawMessage, 0)
-
-	err = json.Unmarshal(data, &rawMessages)
-
-	if err != nil {
-		return &CreateBatchOrdersResponse{}, err
+// batchParams return the params of the order within a batchOrders request
+func (s *CreateOrderService) batchParams() params {
+	m := params{
+		"symbol":           s.symbol,
+		"side":             s.side,
+		"type":             s.orderType,
+		"quantity":         s.quantity,
+		"newOrderRespType": s.newOrderRespType,
 	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.postOnly != nil {
+		m["postOnly"] = *s.postOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.clientOrderID != nil {
+		m["clientOrderId"] = *s.clientOrderID
+	}
+	if s.isMmp != nil {
+		m["isMmp"] = *s.isMmp
+	}
+	return m
+}
 
-	batchCreateOrdersResponse := new(CreateBatchOrdersResponse)
-
-	for _, j := range rawMessages {
-		o := new(SingleOrder)
-		if err := json.Unmarshal(*j, o); err != nil {
-			return &CreateBatchOrdersResponse{}, err
+// parseBatchOrderResults decode a batch response, where each element is
+// either an order or a {code,msg} error
+func parseBatchOrderResults(data []byte) ([]*BatchOrderResult, error) {
+	rawMessages := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(data, &rawMessages); err != nil {
+		return nil, err
+	}
+	results := make([]*BatchOrderResult, 0, len(rawMessages))
+	for _, raw := range rawMessages {
+		var probe struct {
+			Code *int64 `json:"code"`
 		}
-
-		/*
-			TODO: the following 4 lines are copied from futures package, not sure why there is
-			such condition check and it looks wrong to me. Need to double confirm.
-
-			if o.ClientOrderID != "" {
-				batchCreateOrdersResponse.Orders = append(batchCreateOrdersResponse.Orders, o)
-				continue
+		if err := json.Unmarshal(raw, &probe); err != nil {
+			return nil, err
+		}
+		// successful orders don't have a code
+		if probe.Code != nil {
+			apiErr := new(common.APIError)
+			if err := json.Unmarshal(raw, apiErr); err != nil {
+				return nil, err
 			}
-		*/
-		batchCreateOrdersResponse.Orders = append(batchCreateOrdersResponse.Orders, o)
-
+			results = append(results, &BatchOrderResult{Error: apiErr})
+			continue
+		}
+		o := new(SingleOrder)
+		if err := json.Unmarshal(raw, o); err != nil {
+			return nil, err
+		}
+		results = append(results, &BatchOrderResult{Order: o})
 	}
-
-	return batchCreateOrdersResponse, nil
-
+	return results, nil
 }
@@ -1,6 +1,9 @@
 package options
 
 import (
+	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -410,3 +413,36 @@ func (s *orderServiceTestSuite) TestCancelAllOpenOrders() {
 		Do(newContext())
 	s.r().NoError(err)
 }
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	s.client.Client.do = s.client.do
+	order := `{"orderId": 4611875134427365377, "symbol": "BTC-200730-9000-C", "price": "100", "quantity": "1",` +
+		` "side": "BUY", "type": "LIMIT", "clientOrderId": "", "mmp": false},`
+	first := `[` + strings.Repeat(order, 9) + `{"code": -2027, "msg": "Option margin is insufficient."}]`
+	second := `[` + strings.TrimSuffix(order, ",") + `]`
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(first), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(second), http.StatusOK), nil).Once()
+
+	var batchSizes []int
+	s.assertReq(func(r *request) {
+		var orders []params
+		s.r().NoError(json.Unmarshal([]byte(r.form.Get("batchOrders")), &orders))
+		batchSizes = append(batchSizes, len(orders))
+	})
+	orders := make([]*CreateOrderService, 11)
+	for i := range orders {
+		orders[i] = s.client.NewCreateOrderService().Symbol("BTC-200730-9000-C").Side(SideTypeBuy).
+			Type(OrderTypeLimit).Quantity("1").Price("100")
+	}
+	res, err := s.client.NewCreateBatchOrdersService().OrderList(orders).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]int{10, 1}, batchSizes)
+	r.Len(res.Results, 11)
+	r.Len(res.Orders, 10)
+	r.Nil(res.Results[9].Order)
+	r.Equal(int64(-2027), res.Results[9].Error.Code)
+	r.Equal("Option margin is insufficient.", res.Results[9].Error.Message)
+	r.Equal(int64(4611875134427365377), res.Results[10].Order.OrderID)
+	r.Same(res.Results[10].Order, res.Orders[9])
+}
@@ -0,0 +1,557 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// orderListLeg hold the parameters of one order of an order list, they are
+// sent with the prefix of the leg (above, below, working, pending...)
+type orderListLeg struct {
+	orderType       *OrderType
+	side            *SideType
+	clientOrderID   *string
+	price           *string
+	stopPrice       *string
+	trailingDelta   *string
+	quantity        *string
+	icebergQuantity *string
+	timeInForce     *TimeInForceType
+}
+
+func (l *orderListLeg) setParams(m params, prefix string) {
+	if l.orderType != nil {
+		m[prefix+"Type"] = *l.orderType
+	}
+	if l.side != nil {
+		m[prefix+"Side"] = *l.side
+	}
+	if l.clientOrderID != nil {
+		m[prefix+"ClientOrderId"] = *l.clientOrderID
+	}
+	if l.price != nil {
+		m[prefix+"Price"] = *l.price
+	}
+	if l.stopPrice != nil {
+		m[prefix+"StopPrice"] = *l.stopPrice
+	}
+	if l.trailingDelta != nil {
+		m[prefix+"TrailingDelta"] = *l.trailingDelta
+	}
+	if l.quantity != nil {
+		m[prefix+"Quantity"] = *l.quantity
+	}
+	if l.icebergQuantity != nil {
+		m[prefix+"IcebergQty"] = *l.icebergQuantity
+	}
+	if l.timeInForce != nil {
+		m[prefix+"TimeInForce"] = *l.timeInForce
+	}
+}
+
+func createOrderList(ctx context.Context, c *Client, endpoint string, m params, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(m)
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateOrderListResponse define create order list response
+type CreateOrderListResponse struct {
+	OrderListID       int64               `json:"orderListId"`
+	ContingencyType   ContingencyType     `json:"contingencyType"`
+	ListStatusType    ListStatusType      `json:"listStatusType"`
+	ListOrderStatus   ListOrderStatusType `json:"listOrderStatus"`
+	ListClientOrderID string              `json:"listClientOrderId"`
+	TransactionTime   int64               `json:"transactionTime"`
+	Symbol            string              `json:"symbol"`
+	Orders            []*OCOOrder         `json:"orders"`
+	OrderReports      []*OCOOrderReport   `json:"orderReports"`
+}
+
+// CreateOrderListOCOService create an OCO order list: an order above and an
+// order below the market price, one cancelling the other when it executes
+type CreateOrderListOCOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	side              SideType
+	quantity          string
+	above             orderListLeg
+	below             orderListLeg
+	newOrderRespType  *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOCOService) Symbol(symbol string) *CreateOrderListOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Side set side of both orders
+func (s *CreateOrderListOCOService) Side(side SideType) *CreateOrderListOCOService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity of both orders
+func (s *CreateOrderListOCOService) Quantity(quantity string) *CreateOrderListOCOService {
+	s.quantity = quantity
+	return s
+}
+
+// AboveType set aboveType, one of STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT and TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) AboveType(aboveType OrderType) *CreateOrderListOCOService {
+	s.above.orderType = &aboveType
+	return s
+}
+
+// AboveClientOrderID set aboveClientOrderId
+func (s *CreateOrderListOCOService) AboveClientOrderID(aboveClientOrderID string) *CreateOrderListOCOService {
+	s.above.clientOrderID = &aboveClientOrderID
+	return s
+}
+
+// AbovePrice set abovePrice
+func (s *CreateOrderListOCOService) AbovePrice(abovePrice string) *CreateOrderListOCOService {
+	s.above.price = &abovePrice
+	return s
+}
+
+// AboveStopPrice set aboveStopPrice
+func (s *CreateOrderListOCOService) AboveStopPrice(aboveStopPrice string) *CreateOrderListOCOService {
+	s.above.stopPrice = &aboveStopPrice
+	return s
+}
+
+// AboveTrailingDelta set aboveTrailingDelta
+func (s *CreateOrderListOCOService) AboveTrailingDelta(aboveTrailingDelta string) *CreateOrderListOCOService {
+	s.above.trailingDelta = &aboveTrailingDelta
+	return s
+}
+
+// AboveIcebergQuantity set aboveIcebergQty
+func (s *CreateOrderListOCOService) AboveIcebergQuantity(aboveIcebergQuantity string) *CreateOrderListOCOService {
+	s.above.icebergQuantity = &aboveIcebergQuantity
+	return s
+}
+
+// AboveTimeInForce set aboveTimeInForce
+func (s *CreateOrderListOCOService) AboveTimeInForce(aboveTimeInForce TimeInForceType) *CreateOrderListOCOService {
+	s.above.timeInForce = &aboveTimeInForce
+	return s
+}
+
+// BelowType set belowType, one of STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT and TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) BelowType(belowType OrderType) *CreateOrderListOCOService {
+	s.below.orderType = &belowType
+	return s
+}
+
+// BelowClientOrderID set belowClientOrderId
+func (s *CreateOrderListOCOService) BelowClientOrderID(belowClientOrderID string) *CreateOrderListOCOService {
+	s.below.clientOrderID = &belowClientOrderID
+	return s
+}
+
+// BelowPrice set belowPrice
+func (s *CreateOrderListOCOService) BelowPrice(belowPrice string) *CreateOrderListOCOService {
+	s.below.price = &belowPrice
+	return s
+}
+
+// BelowStopPrice set belowStopPrice
+func (s *CreateOrderListOCOService) BelowStopPrice(belowStopPrice string) *CreateOrderListOCOService {
+	s.below.stopPrice = &belowStopPrice
+	return s
+}
+
+// BelowTrailingDelta set belowTrailingDelta
+func (s *CreateOrderListOCOService) BelowTrailingDelta(belowTrailingDelta string) *CreateOrderListOCOService {
+	s.below.trailingDelta = &belowTrailingDelta
+	return s
+}
+
+// BelowIcebergQuantity set belowIcebergQty
+func (s *CreateOrderListOCOService) BelowIcebergQuantity(belowIcebergQuantity string) *CreateOrderListOCOService {
+	s.below.icebergQuantity = &belowIcebergQuantity
+	return s
+}
+
+// BelowTimeInForce set belowTimeInForce
+func (s *CreateOrderListOCOService) BelowTimeInForce(belowTimeInForce TimeInForceType) *CreateOrderListOCOService {
+	s.below.timeInForce = &belowTimeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	s.above.setParams(m, "above")
+	s.below.setParams(m, "below")
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	return createOrderList(ctx, s.c, "/api/v3/orderList/oco", m, opts...)
+}
+
+// CreateOrderListOTOService create an OTO order list: a working order which,
+// once filled, places the pending order
+type CreateOrderListOTOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	working           orderListLeg
+	pending           orderListLeg
+	newOrderRespType  *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOTOService) Symbol(symbol string) *CreateOrderListOTOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOTOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOTOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// WorkingType set workingType, LIMIT or LIMIT_MAKER
+func (s *CreateOrderListOTOService) WorkingType(workingType OrderType) *CreateOrderListOTOService {
+	s.working.orderType = &workingType
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *CreateOrderListOTOService) WorkingSide(workingSide SideType) *CreateOrderListOTOService {
+	s.working.side = &workingSide
+	return s
+}
+
+// WorkingClientOrderID set workingClientOrderId
+func (s *CreateOrderListOTOService) WorkingClientOrderID(workingClientOrderID string) *CreateOrderListOTOService {
+	s.working.clientOrderID = &workingClientOrderID
+	return s
+}
+
+// WorkingPrice set workingPrice
+func (s *CreateOrderListOTOService) WorkingPrice(workingPrice string) *CreateOrderListOTOService {
+	s.working.price = &workingPrice
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *CreateOrderListOTOService) WorkingQuantity(workingQuantity string) *CreateOrderListOTOService {
+	s.working.quantity = &workingQuantity
+	return s
+}
+
+// WorkingIcebergQuantity set workingIcebergQty
+func (s *CreateOrderListOTOService) WorkingIcebergQuantity(workingIcebergQuantity string) *CreateOrderListOTOService {
+	s.working.icebergQuantity = &workingIcebergQuantity
+	return s
+}
+
+// WorkingTimeInForce set workingTimeInForce
+func (s *CreateOrderListOTOService) WorkingTimeInForce(workingTimeInForce TimeInForceType) *CreateOrderListOTOService {
+	s.working.timeInForce = &workingTimeInForce
+	return s
+}
+
+// PendingType set pendingType
+func (s *CreateOrderListOTOService) PendingType(pendingType OrderType) *CreateOrderListOTOService {
+	s.pending.orderType = &pendingType
+	return s
+}
+
+// PendingSide set pendingSide
+func (s *CreateOrderListOTOService) PendingSide(pendingSide SideType) *CreateOrderListOTOService {
+	s.pending.side = &pendingSide
+	return s
+}
+
+// PendingClientOrderID set pendingClientOrderId
+func (s *CreateOrderListOTOService) PendingClientOrderID(pendingClientOrderID string) *CreateOrderListOTOService {
+	s.pending.clientOrderID = &pendingClientOrderID
+	return s
+}
+
+// PendingPrice set pendingPrice
+func (s *CreateOrderListOTOService) PendingPrice(pendingPrice string) *CreateOrderListOTOService {
+	s.pending.price = &pendingPrice
+	return s
+}
+
+// PendingStopPrice set pendingStopPrice
+func (s *CreateOrderListOTOService) PendingStopPrice(pendingStopPrice string) *CreateOrderListOTOService {
+	s.pending.stopPrice = &pendingStopPrice
+	return s
+}
+
+// PendingTrailingDelta set pendingTrailingDelta
+func (s *CreateOrderListOTOService) PendingTrailingDelta(pendingTrailingDelta string) *CreateOrderListOTOService {
+	s.pending.trailingDelta = &pendingTrailingDelta
+	return s
+}
+
+// PendingQuantity set pendingQuantity
+func (s *CreateOrderListOTOService) PendingQuantity(pendingQuantity string) *CreateOrderListOTOService {
+	s.pending.quantity = &pendingQuantity
+	return s
+}
+
+// PendingIcebergQuantity set pendingIcebergQty
+func (s *CreateOrderListOTOService) PendingIcebergQuantity(pendingIcebergQuantity string) *CreateOrderListOTOService {
+	s.pending.icebergQuantity = &pendingIcebergQuantity
+	return s
+}
+
+// PendingTimeInForce set pendingTimeInForce
+func (s *CreateOrderListOTOService) PendingTimeInForce(pendingTimeInForce TimeInForceType) *CreateOrderListOTOService {
+	s.pending.timeInForce = &pendingTimeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOTOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOTOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOTOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	s.working.setParams(m, "working")
+	s.pending.setParams(m, "pending")
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	return createOrderList(ctx, s.c, "/api/v3/orderList/oto", m, opts...)
+}
+
+// CreateOrderListOTOCOService create an OTOCO order list: a working order
+// which, once filled, places a pending OCO pair
+type CreateOrderListOTOCOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	working           orderListLeg
+	pendingSide       SideType
+	pendingQuantity   string
+	pendingAbove      orderListLeg
+	pendingBelow      orderListLeg
+	newOrderRespType  *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOTOCOService) Symbol(symbol string) *CreateOrderListOTOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOTOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOTOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// WorkingType set workingType, LIMIT or LIMIT_MAKER
+func (s *CreateOrderListOTOCOService) WorkingType(workingType OrderType) *CreateOrderListOTOCOService {
+	s.working.orderType = &workingType
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *CreateOrderListOTOCOService) WorkingSide(workingSide SideType) *CreateOrderListOTOCOService {
+	s.working.side = &workingSide
+	return s
+}
+
+// WorkingClientOrderID set workingClientOrderId
+func (s *CreateOrderListOTOCOService) WorkingClientOrderID(workingClientOrderID string) *CreateOrderListOTOCOService {
+	s.working.clientOrderID = &workingClientOrderID
+	return s
+}
+
+// WorkingPrice set workingPrice
+func (s *CreateOrderListOTOCOService) WorkingPrice(workingPrice string) *CreateOrderListOTOCOService {
+	s.working.price = &workingPrice
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *CreateOrderListOTOCOService) WorkingQuantity(workingQuantity string) *CreateOrderListOTOCOService {
+	s.working.quantity = &workingQuantity
+	return s
+}
+
+// WorkingIcebergQuantity set workingIcebergQty
+func (s *CreateOrderListOTOCOService) WorkingIcebergQuantity(workingIcebergQuantity string) *CreateOrderListOTOCOService {
+	s.working.icebergQuantity = &workingIcebergQuantity
+	return s
+}
+
+// WorkingTimeInForce set workingTimeInForce
+func (s *CreateOrderListOTOCOService) WorkingTimeInForce(workingTimeInForce TimeInForceType) *CreateOrderListOTOCOService {
+	s.working.timeInForce = &workingTimeInForce
+	return s
+}
+
+// PendingSide set pendingSide of both pending orders
+func (s *CreateOrderListOTOCOService) PendingSide(pendingSide SideType) *CreateOrderListOTOCOService {
+	s.pendingSide = pendingSide
+	return s
+}
+
+// PendingQuantity set pendingQuantity of both pending orders
+func (s *CreateOrderListOTOCOService) PendingQuantity(pendingQuantity string) *CreateOrderListOTOCOService {
+	s.pendingQuantity = pendingQuantity
+	return s
+}
+
+// PendingAboveType set pendingAboveType
+func (s *CreateOrderListOTOCOService) PendingAboveType(pendingAboveType OrderType) *CreateOrderListOTOCOService {
+	s.pendingAbove.orderType = &pendingAboveType
+	return s
+}
+
+// PendingAboveClientOrderID set pendingAboveClientOrderId
+func (s *CreateOrderListOTOCOService) PendingAboveClientOrderID(pendingAboveClientOrderID string) *CreateOrderListOTOCOService {
+	s.pendingAbove.clientOrderID = &pendingAboveClientOrderID
+	return s
+}
+
+// PendingAbovePrice set pendingAbovePrice
+func (s *CreateOrderListOTOCOService) PendingAbovePrice(pendingAbovePrice string) *CreateOrderListOTOCOService {
+	s.pendingAbove.price = &pendingAbovePrice
+	return s
+}
+
+// PendingAboveStopPrice set pendingAboveStopPrice
+func (s *CreateOrderListOTOCOService) PendingAboveStopPrice(pendingAboveStopPrice string) *CreateOrderListOTOCOService {
+	s.pendingAbove.stopPrice = &pendingAboveStopPrice
+	return s
+}
+
+// PendingAboveTrailingDelta set pendingAboveTrailingDelta
+func (s *CreateOrderListOTOCOService) PendingAboveTrailingDelta(pendingAboveTrailingDelta string) *CreateOrderListOTOCOService {
+	s.pendingAbove.trailingDelta = &pendingAboveTrailingDelta
+	return s
+}
+
+// PendingAboveIcebergQuantity set pendingAboveIcebergQty
+func (s *CreateOrderListOTOCOService) PendingAboveIcebergQuantity(pendingAboveIcebergQuantity string) *CreateOrderListOTOCOService {
+	s.pendingAbove.icebergQuantity = &pendingAboveIcebergQuantity
+	return s
+}
+
+// PendingAboveTimeInForce set pendingAboveTimeInForce
+func (s *CreateOrderListOTOCOService) PendingAboveTimeInForce(pendingAboveTimeInForce TimeInForceType) *CreateOrderListOTOCOService {
+	s.pendingAbove.timeInForce = &pendingAboveTimeInForce
+	return s
+}
+
+// PendingBelowType set pendingBelowType
+func (s *CreateOrderListOTOCOService) PendingBelowType(pendingBelowType OrderType) *CreateOrderListOTOCOService {
+	s.pendingBelow.orderType = &pendingBelowType
+	return s
+}
+
+// PendingBelowClientOrderID set pendingBelowClientOrderId
+func (s *CreateOrderListOTOCOService) PendingBelowClientOrderID(pendingBelowClientOrderID string) *CreateOrderListOTOCOService {
+	s.pendingBelow.clientOrderID = &pendingBelowClientOrderID
+	return s
+}
+
+// PendingBelowPrice set pendingBelowPrice
+func (s *CreateOrderListOTOCOService) PendingBelowPrice(pendingBelowPrice string) *CreateOrderListOTOCOService {
+	s.pendingBelow.price = &pendingBelowPrice
+	return s
+}
+
+// PendingBelowStopPrice set pendingBelowStopPrice
+func (s *CreateOrderListOTOCOService) PendingBelowStopPrice(pendingBelowStopPrice string) *CreateOrderListOTOCOService {
+	s.pendingBelow.stopPrice = &pendingBelowStopPrice
+	return s
+}
+
+// PendingBelowTrailingDelta set pendingBelowTrailingDelta
+func (s *CreateOrderListOTOCOService) PendingBelowTrailingDelta(pendingBelowTrailingDelta string) *CreateOrderListOTOCOService {
+	s.pendingBelow.trailingDelta = &pendingBelowTrailingDelta
+	return s
+}
+
+// PendingBelowIcebergQuantity set pendingBelowIcebergQty
+func (s *CreateOrderListOTOCOService) PendingBelowIcebergQuantity(pendingBelowIcebergQuantity string) *CreateOrderListOTOCOService {
+	s.pendingBelow.icebergQuantity = &pendingBelowIcebergQuantity
+	return s
+}
+
+// PendingBelowTimeInForce set pendingBelowTimeInForce
+func (s *CreateOrderListOTOCOService) PendingBelowTimeInForce(pendingBelowTimeInForce TimeInForceType) *CreateOrderListOTOCOService {
+	s.pendingBelow.timeInForce = &pendingBelowTimeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOTOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOTOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOTOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol":          s.symbol,
+		"pendingSide":     s.pendingSide,
+		"pendingQuantity": s.pendingQuantity,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	s.working.setParams(m, "working")
+	s.pendingAbove.setParams(m, "pendingAbove")
+	s.pendingBelow.setParams(m, "pendingBelow")
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	return createOrderList(ctx, s.c, "/api/v3/orderList/otoco", m, opts...)
+}
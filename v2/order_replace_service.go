@@ -0,0 +1,406 @@
+package binance
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// CancelReplaceOrderService cancel an existing order and place a new order on the same symbol in one request
+type CancelReplaceOrderService struct {
+	c                          *Client
+	symbol                     string
+	side                       SideType
+	orderType                  OrderType
+	cancelReplaceMode          CancelReplaceModeType
+	timeInForce                *TimeInForceType
+	quantity                   *string
+	quoteOrderQty              *string
+	price                      *string
+	cancelNewClientOrderID     *string
+	cancelOrigClientOrderID    *string
+	cancelOrderID              *int64
+	newClientOrderID           *string
+	stopPrice                  *string
+	trailingDelta              *string
+	icebergQuantity            *string
+	newOrderRespType           *NewOrderRespType
+	cancelRestrictions         *CancelRestrictionsType
+	orderRateLimitExceededMode *OrderRateLimitExceededModeType
+}
+
+// Symbol set symbol
+func (s *CancelReplaceOrderService) Symbol(symbol string) *CancelReplaceOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side of the new order
+func (s *CancelReplaceOrderService) Side(side SideType) *CancelReplaceOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type of the new order
+func (s *CancelReplaceOrderService) Type(orderType OrderType) *CancelReplaceOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode. With STOP_ON_FAILURE the new order
+// is not attempted if the cancel fails, with ALLOW_FAILURE it is placed anyway.
+func (s *CancelReplaceOrderService) CancelReplaceMode(mode CancelReplaceModeType) *CancelReplaceOrderService {
+	s.cancelReplaceMode = mode
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CancelReplaceOrderService) TimeInForce(timeInForce TimeInForceType) *CancelReplaceOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CancelReplaceOrderService) Quantity(quantity string) *CancelReplaceOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *CancelReplaceOrderService) QuoteOrderQty(quoteOrderQty string) *CancelReplaceOrderService {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *CancelReplaceOrderService) Price(price string) *CancelReplaceOrderService {
+	s.price = &price
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderId, the client id of the cancel
+func (s *CancelReplaceOrderService) CancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceOrderService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderId, the client id of the order to cancel
+func (s *CancelReplaceOrderService) CancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceOrderService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelOrderID set cancelOrderId, the id of the order to cancel
+func (s *CancelReplaceOrderService) CancelOrderID(cancelOrderID int64) *CancelReplaceOrderService {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId of the new order
+func (s *CancelReplaceOrderService) NewClientOrderID(newClientOrderID string) *CancelReplaceOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CancelReplaceOrderService) StopPrice(stopPrice string) *CancelReplaceOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// TrailingDelta set trailingDelta
+func (s *CancelReplaceOrderService) TrailingDelta(trailingDelta string) *CancelReplaceOrderService {
+	s.trailingDelta = &trailingDelta
+	return s
+}
+
+// IcebergQuantity set icebergQty
+func (s *CancelReplaceOrderService) IcebergQuantity(icebergQuantity string) *CancelReplaceOrderService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CancelReplaceOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CancelReplaceOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions, the cancel fails if the order is not in the given status
+func (s *CancelReplaceOrderService) CancelRestrictions(cancelRestrictions CancelRestrictionsType) *CancelReplaceOrderService {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// OrderRateLimitExceededMode set orderRateLimitExceededMode, CANCEL_ONLY still
+// cancels the order when the unfilled order count is exceeded
+func (s *CancelReplaceOrderService) OrderRateLimitExceededMode(mode OrderRateLimitExceededModeType) *CancelReplaceOrderService {
+	s.orderRateLimitExceededMode = &mode
+	return s
+}
+
+// Do send request. When the cancel or the new order fails, the exchange
+// rejects the request and Do return the *common.APIError together with a
+// response reporting the outcome of each half.
+func (s *CancelReplaceOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelReplaceOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/order/cancelReplace",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":            s.symbol,
+		"side":              s.side,
+		"type":              s.orderType,
+		"cancelReplaceMode": s.cancelReplaceMode,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQty != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQty
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.trailingDelta != nil {
+		m["trailingDelta"] = *s.trailingDelta
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	if s.orderRateLimitExceededMode != nil {
+		m["orderRateLimitExceededMode"] = *s.orderRateLimitExceededMode
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		// partial failures carry the outcome of each half in the error data
+		if apiErr, ok := err.(*common.APIError); ok && len(apiErr.Data) > 0 {
+			res = new(CancelReplaceOrderResponse)
+			if e := json.Unmarshal(apiErr.Data, res); e != nil {
+				return nil, err
+			}
+			return res, err
+		}
+		return nil, err
+	}
+	res = new(CancelReplaceOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelReplaceOrderResponse define cancel replace order response. For each
+// half, exactly one of the response and the error is set when it was attempted.
+type CancelReplaceOrderResponse struct {
+	CancelResult     CancelReplaceResultType `json:"cancelResult"`
+	NewOrderResult   CancelReplaceResultType `json:"newOrderResult"`
+	CancelResponse   *CancelOrderResponse    `json:"-"`
+	CancelError      *common.APIError        `json:"-"`
+	NewOrderResponse *CreateOrderResponse    `json:"-"`
+	NewOrderError    *common.APIError        `json:"-"`
+}
+
+// UnmarshalJSON decode cancelResponse and newOrderResponse, which hold either
+// the order or an error payload
+func (r *CancelReplaceOrderResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		CancelResult     CancelReplaceResultType `json:"cancelResult"`
+		NewOrderResult   CancelReplaceResultType `json:"newOrderResult"`
+		CancelResponse   stdjson.RawMessage      `json:"cancelResponse"`
+		NewOrderResponse stdjson.RawMessage      `json:"newOrderResponse"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.CancelResult = raw.CancelResult
+	r.NewOrderResult = raw.NewOrderResult
+
+	cancelResponse := new(CancelOrderResponse)
+	ok, apiErr, err := unmarshalOrAPIError(raw.CancelResponse, cancelResponse)
+	if err != nil {
+		return err
+	}
+	if ok {
+		r.CancelResponse = cancelResponse
+	}
+	r.CancelError = apiErr
+
+	newOrderResponse := new(CreateOrderResponse)
+	ok, apiErr, err = unmarshalOrAPIError(raw.NewOrderResponse, newOrderResponse)
+	if err != nil {
+		return err
+	}
+	if ok {
+		r.NewOrderResponse = newOrderResponse
+	}
+	r.NewOrderError = apiErr
+	return nil
+}
+
+// unmarshalOrAPIError decode data into v, or into an API error if data is an
+// error payload. It return false without error if data is empty.
+func unmarshalOrAPIError(data stdjson.RawMessage, v interface{}) (ok bool, apiErr *common.APIError, err error) {
+	if len(data) == 0 || string(data) == "null" {
+		return false, nil, nil
+	}
+	var probe struct {
+		Code *int64 `json:"code"`
+	}
+	if err = json.Unmarshal(data, &probe); err != nil {
+		return false, nil, err
+	}
+	if probe.Code != nil {
+		apiErr = new(common.APIError)
+		if err = json.Unmarshal(data, apiErr); err != nil {
+			return false, nil, err
+		}
+		return false, apiErr, nil
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return false, nil, err
+	}
+	return true, nil, nil
+}
+
+// AmendOrderKeepPriorityService reduce the quantity of an open order while keeping its priority in the order book
+type AmendOrderKeepPriorityService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	newClientOrderID  *string
+	newQuantity       string
+}
+
+// Symbol set symbol
+func (s *AmendOrderKeepPriorityService) Symbol(symbol string) *AmendOrderKeepPriorityService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderId
+func (s *AmendOrderKeepPriorityService) OrderID(orderID int64) *AmendOrderKeepPriorityService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderId
+func (s *AmendOrderKeepPriorityService) OrigClientOrderID(origClientOrderID string) *AmendOrderKeepPriorityService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId, the new client id of the amended order
+func (s *AmendOrderKeepPriorityService) NewClientOrderID(newClientOrderID string) *AmendOrderKeepPriorityService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewQuantity set newQty, which must be greater than 0 and less than the order quantity
+func (s *AmendOrderKeepPriorityService) NewQuantity(newQuantity string) *AmendOrderKeepPriorityService {
+	s.newQuantity = newQuantity
+	return s
+}
+
+// Do send request
+func (s *AmendOrderKeepPriorityService) Do(ctx context.Context, opts ...RequestOption) (res *AmendOrderKeepPriorityResponse, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/api/v3/order/amend/keepPriority",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol": s.symbol,
+		"newQty": s.newQuantity,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AmendOrderKeepPriorityResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AmendOrderKeepPriorityResponse define amend order keep priority response,
+// ListStatus is only set when the order is part of an order list
+type AmendOrderKeepPriorityResponse struct {
+	TransactTime int64                   `json:"transactTime"`
+	ExecutionID  int64                   `json:"executionId"`
+	AmendedOrder *AmendedOrder           `json:"amendedOrder"`
+	ListStatus   *AmendedOrderListStatus `json:"listStatus"`
+}
+
+// AmendedOrder define the order after an amend
+type AmendedOrder struct {
+	Symbol                  string          `json:"symbol"`
+	OrderID                 int64           `json:"orderId"`
+	OrderListID             int64           `json:"orderListId"`
+	OrigClientOrderID       string          `json:"origClientOrderId"`
+	ClientOrderID           string          `json:"clientOrderId"`
+	Price                   string          `json:"price"`
+	Quantity                string          `json:"qty"`
+	ExecutedQuantity        string          `json:"executedQty"`
+	PreventedQuantity       string          `json:"preventedQty"`
+	QuoteOrderQuantity      string          `json:"quoteOrderQty"`
+	CumulativeQuoteQuantity string          `json:"cumulativeQuoteQty"`
+	Status                  OrderStatusType `json:"status"`
+	TimeInForce             TimeInForceType `json:"timeInForce"`
+	Type                    OrderType       `json:"type"`
+	Side                    SideType        `json:"side"`
+	WorkingTime             int64           `json:"workingTime"`
+	SelfTradePreventionMode string          `json:"selfTradePreventionMode"`
+}
+
+// AmendedOrderListStatus define the status of the order list an amended order belongs to
+type AmendedOrderListStatus struct {
+	OrderListID       int64       `json:"orderListId"`
+	ContingencyType   string      `json:"contingencyType"`
+	ListOrderStatus   string      `json:"listOrderStatus"`
+	ListClientOrderID string      `json:"listClientOrderId"`
+	Symbol            string      `json:"symbol"`
+	Orders            []*OCOOrder `json:"orders"`
+}
@@ -0,0 +1,222 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderReplaceServiceTestSuite struct {
+	baseOrderTestSuite
+}
+
+func TestOrderReplaceService(t *testing.T) {
+	suite.Run(t, new(orderReplaceServiceTestSuite))
+}
+
+func (s *orderReplaceServiceTestSuite) TestCancelReplaceOrder() {
+	data := []byte(`{
+		"cancelResult": "SUCCESS",
+		"newOrderResult": "SUCCESS",
+		"cancelResponse": {
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "DnLo3vTAQcjha43lAZhZ0y",
+			"orderId": 9,
+			"orderListId": -1,
+			"clientOrderId": "osxN3JXAtJvKvCqGeMWMVR",
+			"transactTime": 1684804350068,
+			"price": "0.01000000",
+			"origQty": "0.000100",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL"
+		},
+		"newOrderResponse": {
+			"symbol": "BTCUSDT",
+			"orderId": 10,
+			"orderListId": -1,
+			"clientOrderId": "wOceeeOzNORyLiQfw7jd8S",
+			"transactTime": 1652928801803,
+			"price": "0.02000000",
+			"origQty": "0.040000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY",
+			"fills": []
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":             "BTCUSDT",
+			"side":               SideTypeBuy,
+			"type":               OrderTypeLimit,
+			"cancelReplaceMode":  CancelReplaceModeStopOnFailure,
+			"timeInForce":        TimeInForceTypeGTC,
+			"quantity":           "0.04",
+			"price":              "0.02",
+			"cancelOrderId":      9,
+			"cancelRestrictions": CancelRestrictionsOnlyNew,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).CancelReplaceMode(CancelReplaceModeStopOnFailure).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.04").Price("0.02").CancelOrderID(9).
+		CancelRestrictions(CancelRestrictionsOnlyNew).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(CancelReplaceResultSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultSuccess, res.NewOrderResult)
+	r.Nil(res.CancelError)
+	r.Nil(res.NewOrderError)
+	r.Equal(int64(9), res.CancelResponse.OrderID)
+	r.Equal(OrderStatusTypeCanceled, res.CancelResponse.Status)
+	s.assertCreateOrderResponseEqual(&CreateOrderResponse{
+		Symbol:                   "BTCUSDT",
+		OrderID:                  10,
+		ClientOrderID:            "wOceeeOzNORyLiQfw7jd8S",
+		TransactTime:             1652928801803,
+		Price:                    "0.02000000",
+		OrigQuantity:             "0.040000",
+		ExecutedQuantity:         "0.00000000",
+		CummulativeQuoteQuantity: "0.00000000",
+		Status:                   OrderStatusTypeNew,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeBuy,
+		Fills:                    []*Fill{},
+	}, res.NewOrderResponse)
+}
+
+func (s *orderReplaceServiceTestSuite) TestCancelReplaceOrderPartialFailure() {
+	data := []byte(`{
+		"code": -2021,
+		"msg": "Order cancel-replace partially failed.",
+		"data": {
+			"cancelResult": "SUCCESS",
+			"newOrderResult": "FAILURE",
+			"cancelResponse": {
+				"symbol": "BTCUSDT",
+				"origClientOrderId": "86M8erehfExV8z2RC8Zo8k",
+				"orderId": 3,
+				"orderListId": -1,
+				"clientOrderId": "G1kLo6aDv2KGNTFcjfTSFq",
+				"price": "0.006123",
+				"origQty": "10000.000000",
+				"executedQty": "0.000000",
+				"cummulativeQuoteQty": "0.000000",
+				"status": "CANCELED",
+				"timeInForce": "GTC",
+				"type": "LIMIT_MAKER",
+				"side": "SELL"
+			},
+			"newOrderResponse": {
+				"code": -2010,
+				"msg": "Order would immediately match and take."
+			}
+		}
+	}`)
+	s.mockDo(data, nil, http.StatusConflict)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimitMaker).CancelReplaceMode(CancelReplaceModeAllowFailure).
+		Quantity("10000").Price("0.0062").CancelOrigClientOrderID("86M8erehfExV8z2RC8Zo8k").Do(newContext())
+	r := s.r()
+	r.Error(err)
+	apiErr, ok := err.(*common.APIError)
+	r.True(ok)
+	r.Equal(int64(-2021), apiErr.Code)
+	r.NotNil(res)
+	r.Equal(CancelReplaceResultSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultFailure, res.NewOrderResult)
+	r.Equal(int64(3), res.CancelResponse.OrderID)
+	r.Nil(res.CancelError)
+	r.Nil(res.NewOrderResponse)
+	r.Equal(int64(-2010), res.NewOrderError.Code)
+	r.Equal("Order would immediately match and take.", res.NewOrderError.Message)
+}
+
+func (s *orderReplaceServiceTestSuite) TestCancelReplaceOrderError() {
+	s.mockDo([]byte(`{"code": -1102, "msg": "Mandatory parameter 'cancelReplaceMode' was not sent."}`), nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Do(newContext())
+	s.r().Nil(res)
+	s.r().True(common.IsAPIError(err))
+}
+
+func (s *orderReplaceServiceTestSuite) TestAmendOrderKeepPriority() {
+	data := []byte(`{
+		"transactTime": 1741926410255,
+		"executionId": 75,
+		"amendedOrder": {
+			"symbol": "BTCUSDT",
+			"orderId": 33,
+			"orderListId": -1,
+			"origClientOrderId": "5xrgbMyg6z36NzBn2pbT8H",
+			"clientOrderId": "PFaq6hIHxqFENGfdtn4J6Q",
+			"price": "6.00000000",
+			"qty": "5.00000000",
+			"executedQty": "0.00000000",
+			"preventedQty": "0.00000000",
+			"quoteOrderQty": "0.00000000",
+			"cumulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL",
+			"workingTime": 1741926410242,
+			"selfTradePreventionMode": "NONE"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"orderId":          33,
+			"newClientOrderId": "PFaq6hIHxqFENGfdtn4J6Q",
+			"newQty":           "5",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderID(33).
+		NewClientOrderID("PFaq6hIHxqFENGfdtn4J6Q").NewQuantity("5").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1741926410255), res.TransactTime)
+	r.Equal(int64(75), res.ExecutionID)
+	r.Nil(res.ListStatus)
+	r.Equal(&AmendedOrder{
+		Symbol:                  "BTCUSDT",
+		OrderID:                 33,
+		OrderListID:             -1,
+		OrigClientOrderID:       "5xrgbMyg6z36NzBn2pbT8H",
+		ClientOrderID:           "PFaq6hIHxqFENGfdtn4J6Q",
+		Price:                   "6.00000000",
+		Quantity:                "5.00000000",
+		ExecutedQuantity:        "0.00000000",
+		PreventedQuantity:       "0.00000000",
+		QuoteOrderQuantity:      "0.00000000",
+		CumulativeQuoteQuantity: "0.00000000",
+		Status:                  OrderStatusTypeNew,
+		TimeInForce:             TimeInForceTypeGTC,
+		Type:                    OrderTypeLimit,
+		Side:                    SideTypeSell,
+		WorkingTime:             1741926410242,
+		SelfTradePreventionMode: "NONE",
+	}, res.AmendedOrder)
+}
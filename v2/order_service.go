@@ -4,23 +4,30 @@ import (
 	"context"
 	stdjson "encoding/json"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateOrderService create order
 type CreateOrderService struct {
-	c                *Client
-	symbol           string
-	side             SideType
-	orderType        OrderType
-	timeInForce      *TimeInForceType
-	newOrderRespType *NewOrderRespType
-	quantity         *string
-	quoteOrderQty    *string
-	price            *string
-	newClientOrderID *string
-	stopPrice        *string
-	trailingDelta    *string
-	icebergQuantity  *string
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	newOrderRespType        *NewOrderRespType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	newClientOrderID        *string
+	stopPrice               *string
+	trailingDelta           *string
+	icebergQuantity         *string
+	selfTradePreventionMode *STPModeType
+	strategyID              *int64
+	strategyType            *int64
+	validateSymbol          *Symbol
+	validateOpts            []ValidateOption
 }
 
 // Symbol set symbol
@@ -95,7 +102,55 @@ func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType)
 	return s
 }
 
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOrderService) SelfTradePreventionMode(mode STPModeType) *CreateOrderService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// StrategyID set strategyId, an arbitrary id to identify the strategy placing the order
+func (s *CreateOrderService) StrategyID(strategyID int64) *CreateOrderService {
+	s.strategyID = &strategyID
+	return s
+}
+
+// StrategyType set strategyType, it can't be less than 1000000
+func (s *CreateOrderService) StrategyType(strategyType int64) *CreateOrderService {
+	s.strategyType = &strategyType
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(d common.Decimal) *CreateOrderService {
+	return s.Quantity(d.String())
+}
+
+// QuoteOrderQtyDecimal set quoteOrderQty from a decimal
+func (s *CreateOrderService) QuoteOrderQtyDecimal(d common.Decimal) *CreateOrderService {
+	return s.QuoteOrderQty(d.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(d common.Decimal) *CreateOrderService {
+	return s.Price(d.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOrderService) StopPriceDecimal(d common.Decimal) *CreateOrderService {
+	return s.StopPrice(d.String())
+}
+
+// IcebergQuantityDecimal set icebergQuantity from a decimal
+func (s *CreateOrderService) IcebergQuantityDecimal(d common.Decimal) *CreateOrderService {
+	return s.IcebergQuantity(d.String())
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	if s.validateSymbol != nil {
+		if err = s.Validate(s.validateSymbol, s.validateOpts...); err != nil {
+			return []byte{}, err
+		}
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -133,6 +188,15 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.strategyID != nil {
+		m["strategyId"] = *s.strategyID
+	}
+	if s.strategyType != nil {
+		m["strategyType"] = *s.strategyType
+	}
 	r.setFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -178,12 +242,37 @@ type CreateOrderResponse struct {
 	Type        OrderType       `json:"type"`
 	Side        SideType        `json:"side"`
 
+	WorkingTime             int64       `json:"workingTime"`
+	WorkingFloor            string      `json:"workingFloor"` // EXCHANGE or SOR
+	UsedSor                 bool        `json:"usedSor"`      // true for orders placed through the SOR
+	SelfTradePreventionMode STPModeType `json:"selfTradePreventionMode"`
+
 	// for order response is set to FULL
 	Fills                 []*Fill `json:"fills"`
 	MarginBuyBorrowAmount string  `json:"marginBuyBorrowAmount"` // for margin
 	MarginBuyBorrowAsset  string  `json:"marginBuyBorrowAsset"`
 }
 
+// PriceDecimal return Price as an exact decimal
+func (r *CreateOrderResponse) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as an exact decimal
+func (r *CreateOrderResponse) OrigQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as an exact decimal
+func (r *CreateOrderResponse) ExecutedQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return CummulativeQuoteQuantity as an exact decimal
+func (r *CreateOrderResponse) CummulativeQuoteQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(r.CummulativeQuoteQuantity)
+}
+
 // Fill may be returned in an array of fills in a CreateOrderResponse.
 type Fill struct {
 	TradeID         int64  `json:"tradeId"`
@@ -191,24 +280,48 @@ type Fill struct {
 	Quantity        string `json:"qty"`
 	Commission      string `json:"commission"`
 	CommissionAsset string `json:"commissionAsset"`
+	MatchType       string `json:"matchType"` // ONE_PARTY_TRADE_REPORT for SOR allocations
+	AllocID         int64  `json:"allocId"`   // set instead of TradeID for SOR allocations
+}
+
+// PriceDecimal return Price as an exact decimal
+func (f *Fill) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(f.Price)
+}
+
+// QuantityDecimal return Quantity as an exact decimal
+func (f *Fill) QuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(f.Quantity)
+}
+
+// CommissionDecimal return Commission as an exact decimal
+func (f *Fill) CommissionDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(f.Commission)
 }
 
 // CreateOCOService create order
 type CreateOCOService struct {
-	c                    *Client
-	symbol               string
-	listClientOrderID    *string
-	side                 SideType
-	quantity             *string
-	limitClientOrderID   *string
-	price                *string
-	limitIcebergQty      *string
-	stopClientOrderID    *string
-	stopPrice            *string
-	stopLimitPrice       *string
-	stopIcebergQty       *string
-	stopLimitTimeInForce *TimeInForceType
-	newOrderRespType     *NewOrderRespType
+	c                       *Client
+	symbol                  string
+	listClientOrderID       *string
+	side                    SideType
+	quantity                *string
+	limitClientOrderID      *string
+	price                   *string
+	limitIcebergQty         *string
+	stopClientOrderID       *string
+	stopPrice               *string
+	stopLimitPrice          *string
+	stopIcebergQty          *string
+	stopLimitTimeInForce    *TimeInForceType
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *STPModeType
+	limitStrategyID         *int64
+	limitStrategyType       *int64
+	stopStrategyID          *int64
+	stopStrategyType        *int64
+	validateSymbol          *Symbol
+	validateOpts            []ValidateOption
 }
 
 // Symbol set symbol
@@ -289,7 +402,72 @@ func (s *CreateOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *
 	return s
 }
 
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOCOService) SelfTradePreventionMode(mode STPModeType) *CreateOCOService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// LimitStrategyID set limitStrategyId
+func (s *CreateOCOService) LimitStrategyID(limitStrategyID int64) *CreateOCOService {
+	s.limitStrategyID = &limitStrategyID
+	return s
+}
+
+// LimitStrategyType set limitStrategyType, it can't be less than 1000000
+func (s *CreateOCOService) LimitStrategyType(limitStrategyType int64) *CreateOCOService {
+	s.limitStrategyType = &limitStrategyType
+	return s
+}
+
+// StopStrategyID set stopStrategyId
+func (s *CreateOCOService) StopStrategyID(stopStrategyID int64) *CreateOCOService {
+	s.stopStrategyID = &stopStrategyID
+	return s
+}
+
+// StopStrategyType set stopStrategyType, it can't be less than 1000000
+func (s *CreateOCOService) StopStrategyType(stopStrategyType int64) *CreateOCOService {
+	s.stopStrategyType = &stopStrategyType
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOCOService) QuantityDecimal(d common.Decimal) *CreateOCOService {
+	return s.Quantity(d.String())
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOCOService) PriceDecimal(d common.Decimal) *CreateOCOService {
+	return s.Price(d.String())
+}
+
+// LimitIcebergQuantityDecimal set limitIcebergQty from a decimal
+func (s *CreateOCOService) LimitIcebergQuantityDecimal(d common.Decimal) *CreateOCOService {
+	return s.LimitIcebergQuantity(d.String())
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOCOService) StopPriceDecimal(d common.Decimal) *CreateOCOService {
+	return s.StopPrice(d.String())
+}
+
+// StopLimitPriceDecimal set stopLimitPrice from a decimal
+func (s *CreateOCOService) StopLimitPriceDecimal(d common.Decimal) *CreateOCOService {
+	return s.StopLimitPrice(d.String())
+}
+
+// StopIcebergQtyDecimal set stopIcebergQty from a decimal
+func (s *CreateOCOService) StopIcebergQtyDecimal(d common.Decimal) *CreateOCOService {
+	return s.StopIcebergQty(d.String())
+}
+
 func (s *CreateOCOService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	if s.validateSymbol != nil {
+		if err = s.Validate(s.validateSymbol, s.validateOpts...); err != nil {
+			return []byte{}, err
+		}
+	}
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -327,6 +505,21 @@ func (s *CreateOCOService) createOrder(ctx context.Context, endpoint string, opt
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.limitStrategyID != nil {
+		m["limitStrategyId"] = *s.limitStrategyID
+	}
+	if s.limitStrategyType != nil {
+		m["limitStrategyType"] = *s.limitStrategyType
+	}
+	if s.stopStrategyID != nil {
+		m["stopStrategyId"] = *s.stopStrategyID
+	}
+	if s.stopStrategyType != nil {
+		m["stopStrategyType"] = *s.stopStrategyType
+	}
 	r.setFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -387,6 +580,7 @@ type OCOOrderReport struct {
 	Side                     SideType        `json:"side"`
 	StopPrice                string          `json:"stopPrice"`
 	IcebergQuantity          string          `json:"icebergQty"`
+	WorkingTime              int64           `json:"workingTime"`
 }
 
 // ListOpenOcoService list opened oco
@@ -532,6 +726,39 @@ type Order struct {
 	IsWorking                bool            `json:"isWorking"`
 	IsIsolated               bool            `json:"isIsolated"`
 	OrigQuoteOrderQuantity   string          `json:"origQuoteOrderQty"`
+	SelfTradePreventionMode  STPModeType     `json:"selfTradePreventionMode"`
+	PreventedMatchID         int64           `json:"preventedMatchId"` // only set if the order expired due to STP
+	PreventedQuantity        string          `json:"preventedQuantity"`
+}
+
+// PriceDecimal return Price as an exact decimal
+func (o *Order) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as an exact decimal
+func (o *Order) OrigQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as an exact decimal
+func (o *Order) ExecutedQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return CummulativeQuoteQuantity as an exact decimal
+func (o *Order) CummulativeQuoteQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.CummulativeQuoteQuantity)
+}
+
+// StopPriceDecimal return StopPrice as an exact decimal
+func (o *Order) StopPriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.StopPrice)
+}
+
+// IcebergQuantityDecimal return IcebergQuantity as an exact decimal
+func (o *Order) IcebergQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(o.IcebergQuantity)
 }
 
 // ListOrdersService all account orders; active, canceled, or filled
@@ -3,6 +3,7 @@ package binance
 import (
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -169,6 +170,176 @@ func (s *orderServiceTestSuite) TestCreateOrderFull() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderWithDecimals() {
+	data := []byte(`{
+		"symbol": "SHIBUSDT",
+		"orderId": 2,
+		"clientOrderId": "myOrder2",
+		"transactTime": 1499827319559,
+		"price": "0.00000812",
+		"origQty": "1000000.00",
+		"executedQty": "1000000.00",
+		"cummulativeQuoteQty": "8.12000000",
+		"status": "FILLED",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "BUY",
+		"fills": [
+			{
+				"price": "0.00000812",
+				"qty": "1000000.00",
+				"commission": "0.00081200",
+				"commissionAsset": "BNB",
+				"tradeId": 56
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	price := common.MustParseDecimal("0.000008123").Quantize(common.MustParseDecimal("0.00000001"), common.RoundDown)
+	quantity := common.MustParseDecimal("1000000.009").Quantize(common.MustParseDecimal("0.01"), common.RoundDown)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":      "SHIBUSDT",
+			"side":        SideTypeBuy,
+			"type":        OrderTypeLimit,
+			"timeInForce": TimeInForceTypeGTC,
+			"quantity":    "1000000.00",
+			"price":       "0.00000812",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("SHIBUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).QuantityDecimal(quantity).
+		PriceDecimal(price).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	executed, err := res.ExecutedQuantityDecimal()
+	r.NoError(err)
+	r.True(executed.Equal(quantity))
+	quote, err := res.CummulativeQuoteQuantityDecimal()
+	r.NoError(err)
+	r.True(quote.Equal(price.Mul(quantity)))
+	r.Len(res.Fills, 1)
+	commission, err := res.Fills[0].CommissionDecimal()
+	r.NoError(err)
+	r.Equal("0.00081200", commission.String())
+}
+
+func (s *orderServiceTestSuite) TestCreateOrderSelfTradePrevention() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 28,
+		"orderListId": -1,
+		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+		"transactTime": 1507725176595,
+		"price": "0.00000000",
+		"origQty": "10.00000000",
+		"executedQty": "0.00000000",
+		"cummulativeQuoteQty": "0.00000000",
+		"status": "EXPIRED_IN_MATCH",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "SELL",
+		"workingTime": 1507725176595,
+		"selfTradePreventionMode": "EXPIRE_TAKER"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeSell,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTC,
+			"quantity":                "10",
+			"price":                   "27000",
+			"selfTradePreventionMode": STPModeExpireTaker,
+			"strategyId":              1,
+			"strategyType":            1000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("10").Price("27000").
+		SelfTradePreventionMode(STPModeExpireTaker).StrategyID(1).StrategyType(1000000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(OrderStatusTypeExpiredInMatch, res.Status)
+	r.Equal(STPModeExpireTaker, res.SelfTradePreventionMode)
+}
+
+func (s *orderServiceTestSuite) TestCreateOCOSelfTradePrevention() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeSell,
+			"quantity":                "1",
+			"price":                   "30000",
+			"stopPrice":               "25000",
+			"selfTradePreventionMode": STPModeExpireBoth,
+			"limitStrategyId":         1,
+			"limitStrategyType":       1000000,
+			"stopStrategyId":          2,
+			"stopStrategyType":        1000001,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewCreateOCOService().Symbol("BTCUSDT").Side(SideTypeSell).Quantity("1").
+		Price("30000").StopPrice("25000").SelfTradePreventionMode(STPModeExpireBoth).
+		LimitStrategyID(1).LimitStrategyType(1000000).StopStrategyID(2).StopStrategyType(1000001).
+		Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *orderServiceTestSuite) TestListPreventedMatches() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"preventedMatchId": 1,
+			"takerOrderId": 5,
+			"makerSymbol": "BTCUSDT",
+			"makerOrderId": 3,
+			"tradeGroupId": 1,
+			"selfTradePreventionMode": "EXPIRE_MAKER",
+			"price": "1.100000",
+			"makerPreventedQuantity": "1.300000",
+			"transactTime": 1669101687094
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":               "BTCUSDT",
+			"orderId":              5,
+			"fromPreventedMatchId": 1,
+			"limit":                100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListPreventedMatchesService().Symbol("BTCUSDT").OrderID(5).
+		FromPreventedMatchID(1).Limit(100).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*PreventedMatch{
+		{
+			Symbol:                  "BTCUSDT",
+			PreventedMatchID:        1,
+			TakerOrderID:            5,
+			MakerSymbol:             "BTCUSDT",
+			MakerOrderID:            3,
+			TradeGroupID:            1,
+			SelfTradePreventionMode: STPModeExpireMaker,
+			Price:                   "1.100000",
+			MakerPreventedQuantity:  "1.300000",
+			TransactTime:            1669101687094,
+		},
+	}, res)
+}
+
 func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderResponse) {
 	r := s.r()
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
@@ -0,0 +1,415 @@
+package binance
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// TrackedOrder define the state of an order kept by an OrderTracker
+type TrackedOrder struct {
+	Symbol                   string
+	OrderID                  int64
+	ClientOrderID            string
+	Side                     SideType
+	Type                     OrderType
+	Price                    common.Decimal
+	Quantity                 common.Decimal
+	Status                   OrderStatusType
+	ExecutedQuantity         common.Decimal
+	CummulativeQuoteQuantity common.Decimal
+	// Fees is the commission paid by asset, summed over the trades received
+	// on the user data stream. The fees of trades missed during a
+	// disconnection are not recovered by a reconciliation.
+	Fees       map[string]common.Decimal
+	TradeCount int
+	UpdateTime int64
+}
+
+// IsOpen return whether the order can still be filled
+func (o *TrackedOrder) IsOpen() bool {
+	return orderStatusRank(o.Status) < orderStatusRankFinal
+}
+
+func (o *TrackedOrder) copy() TrackedOrder {
+	cp := *o
+	cp.Fees = make(map[string]common.Decimal, len(o.Fees))
+	for asset, fee := range o.Fees {
+		cp.Fees[asset] = fee
+	}
+	return cp
+}
+
+const (
+	orderStatusRankNew = iota
+	orderStatusRankWorking
+	orderStatusRankFinal
+)
+
+// orderStatusRank order the statuses so that an order never goes back, e.g.
+// from PARTIALLY_FILLED to NEW when the events are received out of order
+func orderStatusRank(status OrderStatusType) int {
+	switch status {
+	case OrderStatusTypeNew, "":
+		return orderStatusRankNew
+	case OrderStatusTypePartiallyFilled, OrderStatusTypePendingCancel:
+		return orderStatusRankWorking
+	default:
+		return orderStatusRankFinal
+	}
+}
+
+// OrderChangeHandler handle an order change
+type OrderChangeHandler func(o TrackedOrder)
+
+type trackedOrder struct {
+	TrackedOrder
+	tradeIDs map[int64]struct{}
+}
+
+// OrderTracker keep the state of the spot orders from the executionReport
+// events of the user data stream.
+//
+// Events may be duplicated or received out of order: the status of an order
+// only moves forward, its executed quantity only grows and the fees of a
+// trade are counted once per trade id. When an event shows that trades of an
+// order were missed the order is refreshed with GetOrderService, and
+// Reconcile refreshes every order against ListOpenOrdersService, e.g. after
+// the user data stream reconnected.
+//
+// The orders are kept until they are removed with Forget or Prune.
+type OrderTracker struct {
+	c          *Client
+	symbol     string
+	onChange   OrderChangeHandler
+	errHandler ErrHandler
+
+	mu         sync.Mutex
+	wg         sync.WaitGroup
+	byID       map[int64]*trackedOrder
+	byClientID map[string]*trackedOrder
+}
+
+// Symbol set symbol, to track the orders of a single symbol
+func (t *OrderTracker) Symbol(symbol string) *OrderTracker {
+	t.symbol = symbol
+	return t
+}
+
+// OnChange set the handler called with every order that changed
+func (t *OrderTracker) OnChange(handler OrderChangeHandler) *OrderTracker {
+	t.onChange = handler
+	return t
+}
+
+// OnError set the handler of errors met while handling stream events
+func (t *OrderTracker) OnError(errHandler ErrHandler) *OrderTracker {
+	t.errHandler = errHandler
+	return t
+}
+
+// Order return the order with orderID
+func (t *OrderTracker) Order(orderID int64) (TrackedOrder, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	o, ok := t.byID[orderID]
+	if !ok {
+		return TrackedOrder{}, false
+	}
+	return o.copy(), true
+}
+
+// OrderByClientID return the order with clientOrderID
+func (t *OrderTracker) OrderByClientID(clientOrderID string) (TrackedOrder, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	o, ok := t.byClientID[clientOrderID]
+	if !ok {
+		return TrackedOrder{}, false
+	}
+	return o.copy(), true
+}
+
+// Orders return every tracked order, by order id
+func (t *OrderTracker) Orders() []TrackedOrder {
+	return t.list(func(o *TrackedOrder) bool { return true })
+}
+
+// OpenOrders return the orders that can still be filled, by order id
+func (t *OrderTracker) OpenOrders() []TrackedOrder {
+	return t.list(func(o *TrackedOrder) bool { return o.IsOpen() })
+}
+
+func (t *OrderTracker) list(keep func(o *TrackedOrder) bool) []TrackedOrder {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	res := make([]TrackedOrder, 0, len(t.byID))
+	for _, o := range t.byID {
+		if keep(&o.TrackedOrder) {
+			res = append(res, o.copy())
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].OrderID < res[j].OrderID })
+	return res
+}
+
+// Wait block until the refreshes triggered by stream events are done
+func (t *OrderTracker) Wait() {
+	t.wg.Wait()
+}
+
+func (t *OrderTracker) handleErr(err error) {
+	if err != nil && t.errHandler != nil {
+		t.errHandler(err)
+	}
+}
+
+func (t *OrderTracker) notify(o *TrackedOrder) {
+	if o != nil && t.onChange != nil {
+		t.onChange(*o)
+	}
+}
+
+func (t *OrderTracker) order(symbol string, orderID int64, clientOrderID string) *trackedOrder {
+	o, ok := t.byID[orderID]
+	if !ok {
+		o = &trackedOrder{
+			TrackedOrder: TrackedOrder{
+				Symbol:        symbol,
+				OrderID:       orderID,
+				ClientOrderID: clientOrderID,
+				Fees:          make(map[string]common.Decimal),
+			},
+			tradeIDs: make(map[int64]struct{}),
+		}
+		t.byID[orderID] = o
+	}
+	if o.ClientOrderID == "" && clientOrderID != "" {
+		o.ClientOrderID = clientOrderID
+	}
+	// a client order id can be reused once its order is closed, it then
+	// refers to the newest order
+	if o.ClientOrderID != "" {
+		if old, ok := t.byClientID[o.ClientOrderID]; !ok || old.OrderID <= o.OrderID {
+			t.byClientID[o.ClientOrderID] = o
+		}
+	}
+	return o
+}
+
+func (t *OrderTracker) remove(o *trackedOrder) {
+	delete(t.byID, o.OrderID)
+	if t.byClientID[o.ClientOrderID] == o {
+		delete(t.byClientID, o.ClientOrderID)
+	}
+}
+
+// Forget stop tracking the order with orderID, it return whether the order
+// was tracked. An event received later for the order tracks it again.
+func (t *OrderTracker) Forget(orderID int64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	o, ok := t.byID[orderID]
+	if ok {
+		t.remove(o)
+	}
+	return ok
+}
+
+// Prune forget the orders in a final status last updated before updateTime,
+// in milliseconds, and return their number
+func (t *OrderTracker) Prune(updateTime int64) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := 0
+	for _, o := range t.byID {
+		if !o.IsOpen() && o.UpdateTime < updateTime {
+			t.remove(o)
+			n++
+		}
+	}
+	return n
+}
+
+// advance apply a status and cumulative quantities, it return whether the order changed
+func (o *trackedOrder) advance(status OrderStatusType, executed, quote common.Decimal, updateTime int64) bool {
+	changed := false
+	rank, current := orderStatusRank(status), orderStatusRank(o.Status)
+	if status != "" && status != o.Status && (rank > current || (rank == current && current != orderStatusRankFinal)) {
+		o.Status = status
+		changed = true
+	}
+	if executed.GreaterThan(o.ExecutedQuantity) {
+		o.ExecutedQuantity = executed
+		changed = true
+	}
+	if quote.GreaterThan(o.CummulativeQuoteQuantity) {
+		o.CummulativeQuoteQuantity = quote
+		changed = true
+	}
+	if changed && updateTime > o.UpdateTime {
+		o.UpdateTime = updateTime
+	}
+	return changed
+}
+
+// HandleUserData apply the executionReport events
+func (t *OrderTracker) HandleUserData(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeExecutionReport {
+		return
+	}
+	if err := t.HandleOrderUpdate(event.OrderUpdate); err != nil {
+		t.handleErr(err)
+	}
+}
+
+// HandleOrderUpdate apply an executionReport, refreshing the order in the
+// background if some of its trades were missed
+func (t *OrderTracker) HandleOrderUpdate(u WsOrderUpdate) error {
+	if t.symbol != "" && u.Symbol != t.symbol {
+		return nil
+	}
+	values, err := parseTrackerDecimals(u.Price, u.Volume, u.FilledVolume, u.FilledQuoteVolume, u.LatestVolume, u.FeeCost)
+	if err != nil {
+		return err
+	}
+	price, quantity, executed, quote, lastQuantity, fee := values[0], values[1], values[2], values[3], values[4], values[5]
+	// a cancellation carries the client order id of the cancel request
+	clientOrderID := u.ClientOrderId
+	if u.OrigCustomOrderId != "" {
+		clientOrderID = u.OrigCustomOrderId
+	}
+
+	t.mu.Lock()
+	o := t.order(u.Symbol, u.Id, clientOrderID)
+	changed := false
+	if o.Side == "" {
+		o.Side, o.Type, o.Price, o.Quantity = SideType(u.Side), OrderType(u.Type), price, quantity
+		changed = true
+	}
+	gap := false
+	if u.ExecutionType == "TRADE" {
+		if _, seen := o.tradeIDs[u.TradeId]; !seen {
+			o.tradeIDs[u.TradeId] = struct{}{}
+			o.TradeCount++
+			if u.FeeAsset != "" {
+				o.Fees[u.FeeAsset] = o.Fees[u.FeeAsset].Add(fee)
+			}
+			// the executed quantity before this trade was not reached by the trades seen
+			gap = executed.Sub(lastQuantity).GreaterThan(o.ExecutedQuantity)
+			changed = true
+		}
+	}
+	if o.advance(OrderStatusType(u.Status), executed, quote, u.TransactionTime) {
+		changed = true
+	}
+	var res *TrackedOrder
+	if changed {
+		cp := o.copy()
+		res = &cp
+	}
+	t.mu.Unlock()
+	t.notify(res)
+
+	if gap {
+		t.wg.Add(1)
+		go func() {
+			defer t.wg.Done()
+			t.handleErr(t.refresh(context.Background(), u.Symbol, u.Id))
+		}()
+	}
+	return nil
+}
+
+// applyOrder apply an order returned by the REST API
+func (t *OrderTracker) applyOrder(order *Order) error {
+	values, err := parseTrackerDecimals(order.Price, order.OrigQuantity, order.ExecutedQuantity, order.CummulativeQuoteQuantity)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	o := t.order(order.Symbol, order.OrderID, order.ClientOrderID)
+	changed := false
+	if o.Side == "" {
+		o.Side, o.Type, o.Price, o.Quantity = order.Side, order.Type, values[0], values[1]
+		changed = true
+	}
+	if o.advance(order.Status, values[2], values[3], order.UpdateTime) {
+		changed = true
+	}
+	var res *TrackedOrder
+	if changed {
+		cp := o.copy()
+		res = &cp
+	}
+	t.mu.Unlock()
+	t.notify(res)
+	return nil
+}
+
+func (t *OrderTracker) refresh(ctx context.Context, symbol string, orderID int64, opts ...RequestOption) error {
+	order, err := t.c.NewGetOrderService().Symbol(symbol).OrderID(orderID).Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	return t.applyOrder(order)
+}
+
+// Reconcile refresh the tracked orders from the REST API: the open orders
+// are listed with ListOpenOrdersService, and the tracked orders no longer
+// open are fetched with GetOrderService to get their final state.
+func (t *OrderTracker) Reconcile(ctx context.Context, opts ...RequestOption) error {
+	open, err := t.c.NewListOpenOrdersService().Symbol(t.symbol).Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	listed := make(map[int64]struct{}, len(open))
+	for _, order := range open {
+		listed[order.OrderID] = struct{}{}
+		if err = t.applyOrder(order); err != nil {
+			return err
+		}
+	}
+	for _, o := range t.OpenOrders() {
+		if _, ok := listed[o.OrderID]; ok {
+			continue
+		}
+		if err = t.refresh(ctx, o.Symbol, o.OrderID, opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServeUserData serve the user data stream of listenKey into the tracker
+// and reconcile it once connected. Call it again once doneC is closed to
+// reconnect, the orders updated meanwhile are reconciled.
+func (t *OrderTracker) ServeUserData(ctx context.Context, listenKey string) (doneC, stopC chan struct{}, err error) {
+	doneC, stopC, err = WsUserDataServe(listenKey, t.HandleUserData, t.handleErr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = t.Reconcile(ctx); err != nil {
+		close(stopC)
+		return nil, nil, err
+	}
+	return doneC, stopC, nil
+}
+
+// parseTrackerDecimals parse decimal fields, the empty ones being zero
+func parseTrackerDecimals(fields ...string) ([]common.Decimal, error) {
+	values := make([]common.Decimal, len(fields))
+	for i, s := range fields {
+		if s == "" {
+			continue
+		}
+		d, err := common.ParseDecimal(s)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = d
+	}
+	return values, nil
+}
@@ -0,0 +1,209 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderTrackerTestSuite struct {
+	baseTestSuite
+	tracker *OrderTracker
+	changes []TrackedOrder
+	errs    []error
+}
+
+func TestOrderTracker(t *testing.T) {
+	suite.Run(t, new(orderTrackerTestSuite))
+}
+
+func (s *orderTrackerTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.client.Client.do = s.client.do
+	s.changes = nil
+	s.errs = nil
+	s.tracker = s.client.NewOrderTracker().
+		OnChange(func(o TrackedOrder) { s.changes = append(s.changes, o) }).
+		OnError(func(err error) { s.errs = append(s.errs, err) })
+}
+
+func (s *orderTrackerTestSuite) order(orderID int64) TrackedOrder {
+	o, ok := s.tracker.Order(orderID)
+	s.r().True(ok)
+	return o
+}
+
+func newOrderUpdate(orderID int64, executionType, status string) WsOrderUpdate {
+	return WsOrderUpdate{
+		Symbol:        "BTCUSDT",
+		ClientOrderId: "my-order",
+		Side:          "BUY",
+		Type:          "LIMIT",
+		Volume:        "1",
+		Price:         "100",
+		ExecutionType: executionType,
+		Status:        status,
+		Id:            orderID,
+		FilledVolume:  "0",
+		TradeId:       -1,
+	}
+}
+
+func tradeUpdate(orderID int64, status string, tradeID int64, last, filled, fee string) WsOrderUpdate {
+	u := newOrderUpdate(orderID, "TRADE", status)
+	u.TradeId = tradeID
+	u.LatestVolume = last
+	u.FilledVolume = filled
+	u.FeeAsset = "BNB"
+	u.FeeCost = fee
+	return u
+}
+
+func (s *orderTrackerTestSuite) handle(u WsOrderUpdate) {
+	s.tracker.HandleUserData(&WsUserDataEvent{Event: UserDataEventTypeExecutionReport, OrderUpdate: u})
+	s.tracker.Wait()
+}
+
+func (s *orderTrackerTestSuite) TestLifecycle() {
+	s.handle(newOrderUpdate(1, "NEW", "NEW"))
+	o := s.order(1)
+	s.r().Equal(OrderStatusTypeNew, o.Status)
+	s.r().Equal(SideTypeBuy, o.Side)
+	s.assertDecimal("100", o.Price)
+
+	s.handle(tradeUpdate(1, "PARTIALLY_FILLED", 10, "0.4", "0.4", "0.001"))
+	// a duplicated event is ignored
+	s.handle(tradeUpdate(1, "PARTIALLY_FILLED", 10, "0.4", "0.4", "0.001"))
+	s.handle(tradeUpdate(1, "FILLED", 11, "0.6", "1", "0.0015"))
+
+	o, ok := s.tracker.OrderByClientID("my-order")
+	s.r().True(ok)
+	s.r().Equal(OrderStatusTypeFilled, o.Status)
+	s.assertDecimal("1", o.ExecutedQuantity)
+	s.assertDecimal("0.0025", o.Fees["BNB"])
+	s.r().Equal(2, o.TradeCount)
+	s.r().False(o.IsOpen())
+	s.r().Len(s.changes, 3)
+	s.r().Empty(s.tracker.OpenOrders())
+	s.r().Empty(s.client.Calls)
+}
+
+func (s *orderTrackerTestSuite) TestOutOfOrder() {
+	s.handle(tradeUpdate(1, "PARTIALLY_FILLED", 10, "0.4", "0.4", "0.001"))
+	// a late NEW event does not move the order back
+	s.handle(newOrderUpdate(1, "NEW", "NEW"))
+	s.r().Equal(OrderStatusTypePartiallyFilled, s.order(1).Status)
+
+	cancel := newOrderUpdate(1, "CANCELED", "CANCELED")
+	cancel.ClientOrderId = "cancel-request"
+	cancel.OrigCustomOrderId = "my-order"
+	cancel.FilledVolume = "0.7"
+	s.handle(cancel)
+	// the trade of the cancellation is received after it
+	s.handle(tradeUpdate(1, "PARTIALLY_FILLED", 11, "0.3", "0.7", "0.0005"))
+
+	o := s.order(1)
+	s.r().Equal(OrderStatusTypeCanceled, o.Status)
+	s.r().Equal("my-order", o.ClientOrderID)
+	s.assertDecimal("0.7", o.ExecutedQuantity)
+	s.assertDecimal("0.0015", o.Fees["BNB"])
+	_, ok := s.tracker.OrderByClientID("cancel-request")
+	s.r().False(ok)
+	s.r().Empty(s.client.Calls)
+}
+
+func (s *orderTrackerTestSuite) TestMissedTrades() {
+	s.handle(newOrderUpdate(1, "NEW", "NEW"))
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "orderId": 1, "clientOrderId": "my-order", "status": "FILLED", "executedQty": "1", "cummulativeQuoteQty": "100", "updateTime": 1499827319559}`), nil)
+	// the trades before this one were missed
+	s.handle(tradeUpdate(1, "PARTIALLY_FILLED", 12, "0.2", "0.9", "0.0005"))
+
+	s.r().Len(s.client.Calls, 1)
+	req := s.calledRequest(0)
+	s.r().Equal("/api/v3/order", req.URL.Path)
+	s.r().Equal("1", req.URL.Query().Get("orderId"))
+	o := s.order(1)
+	s.r().Equal(OrderStatusTypeFilled, o.Status)
+	s.assertDecimal("1", o.ExecutedQuantity)
+	s.assertDecimal("100", o.CummulativeQuoteQuantity)
+	s.r().Equal(int64(1499827319559), o.UpdateTime)
+	s.r().Empty(s.errs)
+}
+
+func (s *orderTrackerTestSuite) TestReconcile() {
+	s.handle(newOrderUpdate(1, "NEW", "NEW"))
+	second := newOrderUpdate(2, "NEW", "NEW")
+	second.ClientOrderId = "other-order"
+	s.handle(second)
+
+	s.mockDoOnce([]byte(`[
+		{"symbol": "BTCUSDT", "orderId": 1, "clientOrderId": "my-order", "status": "PARTIALLY_FILLED", "executedQty": "0.5", "side": "BUY", "type": "LIMIT"},
+		{"symbol": "ETHUSDT", "orderId": 3, "clientOrderId": "web-order", "status": "NEW", "executedQty": "0", "price": "2000", "origQty": "2", "side": "SELL", "type": "LIMIT"}
+	]`), nil)
+	s.mockDoOnce([]byte(`{"symbol": "BTCUSDT", "orderId": 2, "clientOrderId": "other-order", "status": "CANCELED", "executedQty": "0"}`), nil)
+	s.r().NoError(s.tracker.Reconcile(newContext()))
+
+	s.r().Len(s.client.Calls, 2)
+	s.r().Equal("/api/v3/openOrders", s.calledRequest(0).URL.Path)
+	s.r().Equal("2", s.calledRequest(1).URL.Query().Get("orderId"))
+	s.r().Equal(OrderStatusTypePartiallyFilled, s.order(1).Status)
+	s.assertDecimal("0.5", s.order(1).ExecutedQuantity)
+	s.r().Equal(OrderStatusTypeCanceled, s.order(2).Status)
+	// orders placed elsewhere are tracked too
+	o, ok := s.tracker.OrderByClientID("web-order")
+	s.r().True(ok)
+	s.r().Equal(SideTypeSell, o.Side)
+	s.assertDecimal("2", o.Quantity)
+
+	open := s.tracker.OpenOrders()
+	s.r().Len(open, 2)
+	s.r().Equal(int64(1), open[0].OrderID)
+	s.r().Equal(int64(3), open[1].OrderID)
+	s.r().Len(s.tracker.Orders(), 3)
+}
+
+func (s *orderTrackerTestSuite) TestSymbolAndInvalidEvent() {
+	s.tracker.Symbol("BTCUSDT")
+	other := newOrderUpdate(1, "NEW", "NEW")
+	other.Symbol = "ETHUSDT"
+	s.handle(other)
+	s.r().Empty(s.tracker.Orders())
+
+	invalid := newOrderUpdate(1, "NEW", "NEW")
+	invalid.Price = "x"
+	s.handle(invalid)
+	s.r().Len(s.errs, 1)
+	s.r().Empty(s.tracker.Orders())
+}
+
+func (s *orderTrackerTestSuite) TestForgetAndPrune() {
+	s.handle(newOrderUpdate(1, "NEW", "NEW"))
+	filled := tradeUpdate(1, "FILLED", 10, "1", "1", "0.001")
+	filled.TransactionTime = 1000
+	s.handle(filled)
+
+	// the client order id is reused by a new order once the first one is closed
+	s.handle(newOrderUpdate(2, "NEW", "NEW"))
+	o, ok := s.tracker.OrderByClientID("my-order")
+	s.r().True(ok)
+	s.r().Equal(int64(2), o.OrderID)
+	// a late event of the old order does not take the client order id back
+	s.handle(filled)
+	o, _ = s.tracker.OrderByClientID("my-order")
+	s.r().Equal(int64(2), o.OrderID)
+
+	s.r().Equal(0, s.tracker.Prune(1000))
+	s.r().Equal(1, s.tracker.Prune(1001))
+	_, ok = s.tracker.Order(1)
+	s.r().False(ok)
+	// the open order is kept along with its client order id
+	o, ok = s.tracker.OrderByClientID("my-order")
+	s.r().True(ok)
+	s.r().Equal(int64(2), o.OrderID)
+
+	s.r().True(s.tracker.Forget(2))
+	s.r().False(s.tracker.Forget(2))
+	_, ok = s.tracker.OrderByClientID("my-order")
+	s.r().False(ok)
+	s.r().Empty(s.tracker.Orders())
+}
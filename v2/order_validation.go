@@ -0,0 +1,476 @@
+package binance
+
+import (
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderValidationError define an order rejected by the pre-flight validation.
+// Filter is empty when the failure is not caused by a symbol filter, e.g. a
+// mandatory parameter is missing or the order type is not allowed.
+type OrderValidationError struct {
+	Symbol string
+	Filter SymbolFilterType
+	Param  string
+	Value  string
+	Reason string
+}
+
+// Error return the violated filter, parameter and reason
+func (e *OrderValidationError) Error() string {
+	if e.Filter == "" {
+		return fmt.Sprintf("<OrderValidationError> symbol=%s, param=%s, value=%s: %s", e.Symbol, e.Param, e.Value, e.Reason)
+	}
+	return fmt.Sprintf("<OrderValidationError> symbol=%s, filter=%s, param=%s, value=%s: %s", e.Symbol, e.Filter, e.Param, e.Value, e.Reason)
+}
+
+// IsOrderValidationError check if e is an order validation error
+func IsOrderValidationError(e error) bool {
+	_, ok := e.(*OrderValidationError)
+	return ok
+}
+
+// ValidateOption define option type for the pre-flight order validation
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	referencePrice *common.Decimal
+}
+
+// WithReferencePrice set the average price used to check the PERCENT_PRICE_BY_SIDE
+// filter and the notional of MARKET orders, usually from AveragePriceService.
+// Those checks are skipped without a reference price.
+func WithReferencePrice(price common.Decimal) ValidateOption {
+	return func(o *validateOptions) {
+		o.referencePrice = &price
+	}
+}
+
+func newValidateOptions(opts []ValidateOption) validateOptions {
+	o := validateOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// orderParams gather the order parameters shared by the spot and margin order services
+type orderParams struct {
+	symbol          string
+	side            SideType
+	orderType       OrderType
+	timeInForce     *TimeInForceType
+	quantity        *string
+	quoteOrderQty   *string
+	price           *string
+	stopPrice       *string
+	trailingDelta   *string
+	icebergQuantity *string
+}
+
+// Validate check the order parameters against the filters of symbol, as
+// returned by ExchangeInfoService, and return an *OrderValidationError for the
+// first violation found
+func (s *CreateOrderService) Validate(symbol *Symbol, opts ...ValidateOption) error {
+	p := orderParams{
+		symbol:          s.symbol,
+		side:            s.side,
+		orderType:       s.orderType,
+		timeInForce:     s.timeInForce,
+		quantity:        s.quantity,
+		quoteOrderQty:   s.quoteOrderQty,
+		price:           s.price,
+		stopPrice:       s.stopPrice,
+		trailingDelta:   s.trailingDelta,
+		icebergQuantity: s.icebergQuantity,
+	}
+	v := newOrderValidator(symbol, s.symbol, opts)
+	if v.err == nil && !symbol.IsSpotTradingAllowed {
+		v.fail("", "symbol", s.symbol, "spot trading is not allowed")
+	}
+	v.validateOrder(p)
+	return v.err
+}
+
+// ValidateWith make Do and Test validate the order against symbol before sending it
+func (s *CreateOrderService) ValidateWith(symbol *Symbol, opts ...ValidateOption) *CreateOrderService {
+	s.validateSymbol = symbol
+	s.validateOpts = opts
+	return s
+}
+
+// Validate check the order parameters against the filters of symbol, as
+// returned by ExchangeInfoService, and return an *OrderValidationError for the
+// first violation found
+func (s *CreateMarginOrderService) Validate(symbol *Symbol, opts ...ValidateOption) error {
+	p := orderParams{
+		symbol:          s.symbol,
+		side:            s.side,
+		orderType:       s.orderType,
+		timeInForce:     s.timeInForce,
+		quantity:        s.quantity,
+		quoteOrderQty:   s.quoteOrderQty,
+		price:           s.price,
+		stopPrice:       s.stopPrice,
+		icebergQuantity: s.icebergQuantity,
+	}
+	v := newOrderValidator(symbol, s.symbol, opts)
+	if v.err == nil && !symbol.IsMarginTradingAllowed {
+		v.fail("", "symbol", s.symbol, "margin trading is not allowed")
+	}
+	v.validateOrder(p)
+	return v.err
+}
+
+// ValidateWith make Do validate the order against symbol before sending it
+func (s *CreateMarginOrderService) ValidateWith(symbol *Symbol, opts ...ValidateOption) *CreateMarginOrderService {
+	s.validateSymbol = symbol
+	s.validateOpts = opts
+	return s
+}
+
+// Validate check the OCO parameters against the filters of symbol, as
+// returned by ExchangeInfoService, and return an *OrderValidationError for the
+// first violation found
+func (s *CreateOCOService) Validate(symbol *Symbol, opts ...ValidateOption) error {
+	v := newOrderValidator(symbol, s.symbol, opts)
+	if v.err == nil && !symbol.OcoAllowed {
+		v.fail("", "symbol", s.symbol, "OCO orders are not allowed")
+	}
+	v.require("side", s.side != "")
+	v.require("quantity", s.quantity != nil)
+	v.require("price", s.price != nil)
+	v.require("stopPrice", s.stopPrice != nil)
+	if s.stopLimitPrice != nil {
+		v.require("stopLimitTimeInForce", s.stopLimitTimeInForce != nil)
+	}
+	if v.err != nil {
+		return v.err
+	}
+	if s.limitIcebergQty != nil || s.stopIcebergQty != nil {
+		v.icebergAllowed()
+	}
+	quantity := v.decimal("quantity", *s.quantity)
+	price := v.decimal("price", *s.price)
+	v.checkPrice("price", *s.price)
+	v.checkPrice("stopPrice", *s.stopPrice)
+	v.checkLotSize("quantity", *s.quantity)
+	v.checkNotional(price.Mul(quantity), false)
+	v.checkPercentPrice(s.side, price)
+	if s.stopLimitPrice != nil {
+		v.checkPrice("stopLimitPrice", *s.stopLimitPrice)
+		v.checkNotional(v.decimal("stopLimitPrice", *s.stopLimitPrice).Mul(quantity), false)
+	} else {
+		v.checkNotional(v.decimal("stopPrice", *s.stopPrice).Mul(quantity), true)
+	}
+	if s.limitIcebergQty != nil {
+		v.checkIcebergParts(quantity, "limitIcebergQty", *s.limitIcebergQty)
+	}
+	if s.stopIcebergQty != nil {
+		v.checkIcebergParts(quantity, "stopIcebergQty", *s.stopIcebergQty)
+	}
+	return v.err
+}
+
+// ValidateWith make Do validate the OCO against symbol before sending it
+func (s *CreateOCOService) ValidateWith(symbol *Symbol, opts ...ValidateOption) *CreateOCOService {
+	s.validateSymbol = symbol
+	s.validateOpts = opts
+	return s
+}
+
+// orderValidator keep the first validation error, so checks can be chained
+type orderValidator struct {
+	symbol *Symbol
+	name   string
+	opts   validateOptions
+	err    error
+}
+
+func newOrderValidator(symbol *Symbol, name string, opts []ValidateOption) *orderValidator {
+	v := &orderValidator{symbol: symbol, name: name, opts: newValidateOptions(opts)}
+	switch {
+	case name == "":
+		v.fail("", "symbol", "", "is required")
+	case symbol == nil:
+		v.fail("", "symbol", name, "no exchange info for symbol")
+	case symbol.Symbol != name:
+		v.fail("", "symbol", name, fmt.Sprintf("exchange info is for %s", symbol.Symbol))
+	case symbol.Status != string(SymbolStatusTypeTrading):
+		v.fail("", "symbol", name, fmt.Sprintf("symbol status is %s", symbol.Status))
+	}
+	return v
+}
+
+func (v *orderValidator) fail(filter SymbolFilterType, param, value, reason string) {
+	if v.err != nil {
+		return
+	}
+	v.err = &OrderValidationError{
+		Symbol: v.name,
+		Filter: filter,
+		Param:  param,
+		Value:  value,
+		Reason: reason,
+	}
+}
+
+func (v *orderValidator) require(param string, present bool) {
+	if !present {
+		v.fail("", param, "", "is required")
+	}
+}
+
+func (v *orderValidator) forbid(param string, value *string, reason string) {
+	if value != nil {
+		v.fail("", param, *value, reason)
+	}
+}
+
+// decimal parse a parameter or a filter value, recording an error if it is not a number
+func (v *orderValidator) decimal(param, value string) common.Decimal {
+	d, err := common.ParseDecimal(value)
+	if err != nil {
+		v.fail("", param, value, "invalid number")
+	}
+	return d
+}
+
+func (v *orderValidator) icebergAllowed() {
+	if v.err == nil && !v.symbol.IcebergAllowed {
+		v.fail("", "icebergQty", "", "iceberg orders are not allowed")
+	}
+}
+
+func (v *orderValidator) validateOrder(p orderParams) {
+	v.require("side", p.side != "")
+	v.require("type", p.orderType != "")
+	if v.err != nil {
+		return
+	}
+	allowed := len(v.symbol.OrderTypes) == 0
+	for _, t := range v.symbol.OrderTypes {
+		if t == string(p.orderType) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		v.fail("", "type", string(p.orderType), "order type is not allowed")
+	}
+
+	isMarket := false
+	switch p.orderType {
+	case OrderTypeLimit:
+		v.require("timeInForce", p.timeInForce != nil)
+		v.require("quantity", p.quantity != nil)
+		v.require("price", p.price != nil)
+	case OrderTypeLimitMaker:
+		v.require("quantity", p.quantity != nil)
+		v.require("price", p.price != nil)
+	case OrderTypeMarket:
+		isMarket = true
+		if p.quantity == nil && p.quoteOrderQty == nil {
+			v.fail("", "quantity", "", "either quantity or quoteOrderQty is required")
+		}
+		if p.quantity != nil {
+			v.forbid("quoteOrderQty", p.quoteOrderQty, "cannot be sent with quantity")
+		}
+		if p.quoteOrderQty != nil && !v.symbol.QuoteOrderQtyMarketAllowed {
+			v.fail("", "quoteOrderQty", *p.quoteOrderQty, "quote order quantity is not allowed")
+		}
+		v.forbid("price", p.price, "cannot be sent with a MARKET order")
+	case OrderTypeStopLoss, OrderTypeTakeProfit:
+		isMarket = true
+		v.require("quantity", p.quantity != nil)
+		if p.stopPrice == nil && p.trailingDelta == nil {
+			v.fail("", "stopPrice", "", "either stopPrice or trailingDelta is required")
+		}
+	case OrderTypeStopLossLimit, OrderTypeTakeProfitLimit:
+		v.require("timeInForce", p.timeInForce != nil)
+		v.require("quantity", p.quantity != nil)
+		v.require("price", p.price != nil)
+		if p.stopPrice == nil && p.trailingDelta == nil {
+			v.fail("", "stopPrice", "", "either stopPrice or trailingDelta is required")
+		}
+	}
+	if p.icebergQuantity != nil {
+		v.icebergAllowed()
+		if p.timeInForce != nil && *p.timeInForce != TimeInForceTypeGTC {
+			v.fail("", "timeInForce", string(*p.timeInForce), "iceberg orders must be GTC")
+		}
+	}
+	if v.err != nil {
+		return
+	}
+
+	var price, quantity common.Decimal
+	if p.price != nil {
+		price = v.decimal("price", *p.price)
+		v.checkPrice("price", *p.price)
+	}
+	if p.stopPrice != nil {
+		v.checkPrice("stopPrice", *p.stopPrice)
+		if p.price == nil {
+			// STOP_LOSS and TAKE_PROFIT are executed around their stop price
+			price = v.decimal("stopPrice", *p.stopPrice)
+		}
+	}
+	if p.quantity != nil {
+		quantity = v.decimal("quantity", *p.quantity)
+		if isMarket {
+			v.checkMarketLotSize("quantity", *p.quantity)
+		} else {
+			v.checkLotSize("quantity", *p.quantity)
+		}
+	}
+	if p.icebergQuantity != nil {
+		v.checkLotSize("icebergQty", *p.icebergQuantity)
+		v.checkIcebergParts(quantity, "icebergQty", *p.icebergQuantity)
+	}
+	if p.trailingDelta != nil {
+		v.checkTrailingDelta(p.orderType, p.side, *p.trailingDelta)
+	}
+	if p.price != nil {
+		v.checkPercentPrice(p.side, price)
+	}
+
+	switch {
+	case p.quoteOrderQty != nil:
+		v.checkNotional(v.decimal("quoteOrderQty", *p.quoteOrderQty), true)
+	case !price.IsZero():
+		v.checkNotional(price.Mul(quantity), isMarket)
+	case v.opts.referencePrice != nil:
+		v.checkNotional(v.opts.referencePrice.Mul(quantity), isMarket)
+	}
+}
+
+func (v *orderValidator) checkPrice(param, value string) {
+	f := v.symbol.PriceFilter()
+	if v.err != nil || f == nil {
+		return
+	}
+	price := v.decimal(param, value)
+	min := v.decimal("minPrice", f.MinPrice)
+	max := v.decimal("maxPrice", f.MaxPrice)
+	tick := v.decimal("tickSize", f.TickSize)
+	switch {
+	case v.err != nil:
+	case !min.IsZero() && price.LessThan(min):
+		v.fail(SymbolFilterTypePriceFilter, param, value, fmt.Sprintf("lower than minPrice %s", f.MinPrice))
+	case !max.IsZero() && price.GreaterThan(max):
+		v.fail(SymbolFilterTypePriceFilter, param, value, fmt.Sprintf("greater than maxPrice %s", f.MaxPrice))
+	case !price.Sub(min).IsMultipleOf(tick):
+		v.fail(SymbolFilterTypePriceFilter, param, value, fmt.Sprintf("not a multiple of tickSize %s", f.TickSize))
+	}
+}
+
+func (v *orderValidator) checkLotSize(param, value string) {
+	if f := v.symbol.LotSizeFilter(); f != nil {
+		v.checkQuantity(SymbolFilterTypeLotSize, f.MinQuantity, f.MaxQuantity, f.StepSize, param, value)
+	}
+}
+
+func (v *orderValidator) checkMarketLotSize(param, value string) {
+	if f := v.symbol.MarketLotSizeFilter(); f != nil {
+		v.checkQuantity(SymbolFilterTypeMarketLotSize, f.MinQuantity, f.MaxQuantity, f.StepSize, param, value)
+	}
+	// LOT_SIZE also applies to MARKET orders
+	v.checkLotSize(param, value)
+}
+
+func (v *orderValidator) checkQuantity(filter SymbolFilterType, minQty, maxQty, stepSize, param, value string) {
+	if v.err != nil {
+		return
+	}
+	quantity := v.decimal(param, value)
+	min := v.decimal("minQty", minQty)
+	max := v.decimal("maxQty", maxQty)
+	step := v.decimal("stepSize", stepSize)
+	switch {
+	case v.err != nil:
+	case quantity.LessThan(min) || !quantity.IsPositive():
+		v.fail(filter, param, value, fmt.Sprintf("lower than minQty %s", minQty))
+	case !max.IsZero() && quantity.GreaterThan(max):
+		v.fail(filter, param, value, fmt.Sprintf("greater than maxQty %s", maxQty))
+	case !quantity.Sub(min).IsMultipleOf(step):
+		v.fail(filter, param, value, fmt.Sprintf("not a multiple of stepSize %s", stepSize))
+	}
+}
+
+func (v *orderValidator) checkNotional(notional common.Decimal, isMarket bool) {
+	f := v.symbol.NotionalFilter()
+	if v.err != nil || f == nil {
+		return
+	}
+	min := v.decimal("minNotional", f.MinNotional)
+	max := v.decimal("maxNotional", f.MaxNotional)
+	switch {
+	case v.err != nil:
+	case (!isMarket || f.ApplyMinToMarket) && notional.LessThan(min):
+		v.fail(SymbolFilterTypeNotional, "notional", notional.String(), fmt.Sprintf("lower than minNotional %s", f.MinNotional))
+	case (!isMarket || f.ApplyMaxToMarket) && !max.IsZero() && notional.GreaterThan(max):
+		v.fail(SymbolFilterTypeNotional, "notional", notional.String(), fmt.Sprintf("greater than maxNotional %s", f.MaxNotional))
+	}
+}
+
+func (v *orderValidator) checkPercentPrice(side SideType, price common.Decimal) {
+	f := v.symbol.PercentPriceBySideFilter()
+	if v.err != nil || f == nil || v.opts.referencePrice == nil {
+		return
+	}
+	up, down := f.AskMultiplierUp, f.AskMultiplierDown
+	if side == SideTypeBuy {
+		up, down = f.BidMultiplierUp, f.BidMultiplierDown
+	}
+	ref := *v.opts.referencePrice
+	maxPrice := ref.Mul(v.decimal("multiplierUp", up))
+	minPrice := ref.Mul(v.decimal("multiplierDown", down))
+	switch {
+	case v.err != nil:
+	case price.GreaterThan(maxPrice):
+		v.fail(SymbolFilterTypePercentPriceBySide, "price", price.String(), fmt.Sprintf("greater than %s (%s x %s)", maxPrice, ref, up))
+	case price.LessThan(minPrice):
+		v.fail(SymbolFilterTypePercentPriceBySide, "price", price.String(), fmt.Sprintf("lower than %s (%s x %s)", minPrice, ref, down))
+	}
+}
+
+func (v *orderValidator) checkIcebergParts(quantity common.Decimal, param, value string) {
+	f := v.symbol.IcebergPartsFilter()
+	if v.err != nil || f == nil {
+		return
+	}
+	iceberg := v.decimal(param, value)
+	if v.err != nil {
+		return
+	}
+	if !iceberg.IsPositive() || iceberg.GreaterThanOrEqual(quantity) {
+		v.fail(SymbolFilterTypeIcebergParts, param, value, "must be positive and lower than quantity")
+		return
+	}
+	parts := quantity.Div(iceberg, 0, common.RoundCeiling)
+	if parts.GreaterThan(common.NewDecimalFromInt(int64(f.Limit))) {
+		v.fail(SymbolFilterTypeIcebergParts, param, value, fmt.Sprintf("%s parts exceed the limit of %d", parts, f.Limit))
+	}
+}
+
+func (v *orderValidator) checkTrailingDelta(orderType OrderType, side SideType, value string) {
+	f := v.symbol.TrailingDeltaFilter()
+	if v.err != nil || f == nil {
+		return
+	}
+	delta := v.decimal("trailingDelta", value)
+	if v.err != nil {
+		return
+	}
+	// the stop price of a BUY stop loss or a SELL take profit is above the market
+	above := (side == SideTypeBuy) == (orderType == OrderTypeStopLoss || orderType == OrderTypeStopLossLimit)
+	min, max := f.MinTrailingBelowDelta, f.MaxTrailingBelowDelta
+	if above {
+		min, max = f.MinTrailingAboveDelta, f.MaxTrailingAboveDelta
+	}
+	if delta.LessThan(common.NewDecimalFromInt(int64(min))) || delta.GreaterThan(common.NewDecimalFromInt(int64(max))) {
+		v.fail(SymbolFilterTypeTrailingDelta, "trailingDelta", value, fmt.Sprintf("out of range [%d, %d]", min, max))
+	}
+}
@@ -0,0 +1,162 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderValidationTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderValidation(t *testing.T) {
+	suite.Run(t, new(orderValidationTestSuite))
+}
+
+func newValidationSymbol() *Symbol {
+	return &Symbol{
+		Symbol:                     "BTCUSDT",
+		Status:                     string(SymbolStatusTypeTrading),
+		BaseAsset:                  "BTC",
+		QuoteAsset:                 "USDT",
+		OrderTypes:                 []string{"LIMIT", "LIMIT_MAKER", "MARKET", "STOP_LOSS_LIMIT", "TAKE_PROFIT_LIMIT"},
+		IcebergAllowed:             true,
+		OcoAllowed:                 true,
+		QuoteOrderQtyMarketAllowed: true,
+		IsSpotTradingAllowed:       true,
+		IsMarginTradingAllowed:     false,
+		Filters: []map[string]interface{}{
+			{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.01000000"},
+			{"filterType": "LOT_SIZE", "minQty": "0.00001000", "maxQty": "9000.00000000", "stepSize": "0.00001000"},
+			{"filterType": "ICEBERG_PARTS", "limit": float64(10)},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": "0.00000000", "maxQty": "100.00000000", "stepSize": "0.00000000"},
+			{"filterType": "TRAILING_DELTA", "minTrailingAboveDelta": float64(10), "maxTrailingAboveDelta": float64(2000), "minTrailingBelowDelta": float64(10), "maxTrailingBelowDelta": float64(2000)},
+			{"filterType": "PERCENT_PRICE_BY_SIDE", "bidMultiplierUp": "5", "bidMultiplierDown": "0.2", "askMultiplierUp": "5", "askMultiplierDown": "0.2", "avgPriceMins": float64(5)},
+			{"filterType": "NOTIONAL", "minNotional": "5.00000000", "applyMinToMarket": true, "maxNotional": "9000000.00000000", "applyMaxToMarket": false, "avgPriceMins": float64(5)},
+			{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": float64(200)},
+		},
+	}
+}
+
+func (s *orderValidationTestSuite) assertValidationError(err error, filter SymbolFilterType, param string) {
+	r := s.r()
+	r.Error(err)
+	r.True(IsOrderValidationError(err), err.Error())
+	e := err.(*OrderValidationError)
+	r.Equal(filter, e.Filter, err.Error())
+	r.Equal(param, e.Param, err.Error())
+}
+
+func (s *orderValidationTestSuite) newLimitOrder() *CreateOrderService {
+	return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.001").Price("27000.01")
+}
+
+func (s *orderValidationTestSuite) TestValidateCreateOrder() {
+	symbol := newValidationSymbol()
+	s.r().NoError(s.newLimitOrder().Validate(symbol))
+
+	tests := []struct {
+		name   string
+		order  *CreateOrderService
+		filter SymbolFilterType
+		param  string
+	}{
+		{"missing price", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1"), "", "price"},
+		{"missing timeInForce", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeLimit).Quantity("1").Price("1"), "", "timeInForce"},
+		{"missing side", s.client.NewCreateOrderService().Symbol("BTCUSDT").
+			Type(OrderTypeMarket).Quantity("1"), "", "side"},
+		{"market without quantity", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeMarket), "", "quantity"},
+		{"stop limit without stop price", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeStopLossLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("1"), "", "stopPrice"},
+		{"order type not allowed", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeStopLoss).Quantity("1").StopPrice("1"), "", "type"},
+		{"wrong symbol", s.newLimitOrder().Symbol("ETHUSDT"), "", "symbol"},
+		{"tick size", s.newLimitOrder().Price("27000.015"), SymbolFilterTypePriceFilter, "price"},
+		{"min price", s.newLimitOrder().Price("0.001"), SymbolFilterTypePriceFilter, "price"},
+		{"max price", s.newLimitOrder().Price("1000000.01"), SymbolFilterTypePriceFilter, "price"},
+		{"step size", s.newLimitOrder().Quantity("0.000015"), SymbolFilterTypeLotSize, "quantity"},
+		{"max quantity", s.newLimitOrder().Quantity("9000.1"), SymbolFilterTypeLotSize, "quantity"},
+		{"min notional", s.newLimitOrder().Quantity("0.0001").Price("100"), SymbolFilterTypeNotional, "notional"},
+		{"market lot size", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeMarket).Quantity("101"), SymbolFilterTypeMarketLotSize, "quantity"},
+		{"market quote notional", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+			Type(OrderTypeMarket).QuoteOrderQty("4.99"), SymbolFilterTypeNotional, "notional"},
+		{"iceberg parts", s.newLimitOrder().Quantity("1").IcebergQuantity("0.09"), SymbolFilterTypeIcebergParts, "icebergQty"},
+		{"iceberg must be GTC", s.newLimitOrder().Quantity("1").IcebergQuantity("0.5").TimeInForce(TimeInForceTypeIOC), "", "timeInForce"},
+		{"trailing delta", s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+			Type(OrderTypeStopLossLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("27000").
+			TrailingDelta("5"), SymbolFilterTypeTrailingDelta, "trailingDelta"},
+		{"invalid number", s.newLimitOrder().Quantity("1,5"), "", "quantity"},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.assertValidationError(tt.order.Validate(symbol), tt.filter, tt.param)
+		})
+	}
+
+	s.r().NoError(s.newLimitOrder().Quantity("1").IcebergQuantity("0.1").Validate(symbol))
+
+	symbol.Status = string(SymbolStatusTypeHalt)
+	s.assertValidationError(s.newLimitOrder().Validate(symbol), "", "symbol")
+}
+
+func (s *orderValidationTestSuite) TestValidatePercentPriceBySide() {
+	symbol := newValidationSymbol()
+	avgPrice := WithReferencePrice(common.MustParseDecimal("5000"))
+	s.r().NoError(s.newLimitOrder().Price("24999.99").Validate(symbol, avgPrice))
+	s.assertValidationError(s.newLimitOrder().Price("25000.01").Validate(symbol, avgPrice),
+		SymbolFilterTypePercentPriceBySide, "price")
+	s.assertValidationError(s.newLimitOrder().Side(SideTypeSell).Price("999.99").Validate(symbol, avgPrice),
+		SymbolFilterTypePercentPriceBySide, "price")
+
+	// market notional is only known with a reference price
+	market := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("0.0001")
+	s.r().NoError(market.Validate(symbol))
+	s.assertValidationError(market.Validate(symbol, avgPrice), SymbolFilterTypeNotional, "notional")
+}
+
+func (s *orderValidationTestSuite) TestValidateWithSkipsRequest() {
+	s.mockDo([]byte(`{}`), nil)
+	_, err := s.newLimitOrder().Price("27000.001").ValidateWith(newValidationSymbol()).Do(newContext())
+	s.assertValidationError(err, SymbolFilterTypePriceFilter, "price")
+	err = s.newLimitOrder().Quantity("0").ValidateWith(newValidationSymbol()).Test(newContext())
+	s.assertValidationError(err, SymbolFilterTypeLotSize, "quantity")
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *orderValidationTestSuite) TestValidateCreateOCO() {
+	symbol := newValidationSymbol()
+	newOCO := func() *CreateOCOService {
+		return s.client.NewCreateOCOService().Symbol("BTCUSDT").Side(SideTypeSell).Quantity("0.01").
+			Price("30000").StopPrice("25000").StopLimitPrice("24990").StopLimitTimeInForce(TimeInForceTypeGTC)
+	}
+	s.r().NoError(newOCO().Validate(symbol))
+	s.assertValidationError(s.client.NewCreateOCOService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Quantity("0.01").Price("30000").Validate(symbol), "", "stopPrice")
+	s.assertValidationError(s.client.NewCreateOCOService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Quantity("0.01").Price("30000").StopPrice("25000").StopLimitPrice("24990").Validate(symbol), "", "stopLimitTimeInForce")
+	s.assertValidationError(newOCO().StopLimitPrice("24990.001").Validate(symbol), SymbolFilterTypePriceFilter, "stopLimitPrice")
+	s.assertValidationError(newOCO().Quantity("0.0001").Validate(symbol), SymbolFilterTypeNotional, "notional")
+	s.assertValidationError(newOCO().LimitIcebergQuantity("0.0001").Validate(symbol), SymbolFilterTypeIcebergParts, "limitIcebergQty")
+
+	symbol.OcoAllowed = false
+	s.assertValidationError(newOCO().Validate(symbol), "", "symbol")
+}
+
+func (s *orderValidationTestSuite) TestValidateCreateMarginOrder() {
+	symbol := newValidationSymbol()
+	order := s.client.NewCreateMarginOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.001").Price("27000.01")
+	s.assertValidationError(order.Validate(symbol), "", "symbol")
+
+	symbol.IsMarginTradingAllowed = true
+	s.r().NoError(order.Validate(symbol))
+	s.assertValidationError(order.Quantity("0.0000001").Validate(symbol), SymbolFilterTypeLotSize, "quantity")
+}
@@ -2,6 +2,7 @@ package binance
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/adshao/go-binance/v2/common"
@@ -50,6 +51,26 @@ type BookTicker struct {
 	AskQuantity string `json:"askQty"`
 }
 
+// BidPriceDecimal return BidPrice as an exact decimal
+func (t *BookTicker) BidPriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.BidPrice)
+}
+
+// BidQuantityDecimal return BidQuantity as an exact decimal
+func (t *BookTicker) BidQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.BidQuantity)
+}
+
+// AskPriceDecimal return AskPrice as an exact decimal
+func (t *BookTicker) AskPriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.AskPrice)
+}
+
+// AskQuantityDecimal return AskQuantity as an exact decimal
+func (t *BookTicker) AskQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.AskQuantity)
+}
+
 // ListPricesService list latest price for a symbol or symbols
 type ListPricesService struct {
 	c       *Client
@@ -94,6 +115,11 @@ type SymbolPrice struct {
 	Price  string `json:"price"`
 }
 
+// PriceDecimal return Price as an exact decimal
+func (p *SymbolPrice) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(p.Price)
+}
+
 // ListPriceChangeStatsService show stats of price change in last 24 hours for all symbols
 type ListPriceChangeStatsService struct {
 	c       *Client
@@ -260,6 +286,12 @@ func (s *ListSymbolTickerService) WindowSize(windowSize string) *ListSymbolTicke
 	return s
 }
 
+// Window set windowSize from a WindowSize, such as WindowSize4h or
+// WindowSizeMinutes(15)
+func (s *ListSymbolTickerService) Window(windowSize WindowSize) *ListSymbolTickerService {
+	return s.WindowSize(string(windowSize))
+}
+
 func (s *ListSymbolTickerService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolTicker, err error) {
 	r := &request{
 		method:   http.MethodGet,
@@ -288,3 +320,84 @@ func (s *ListSymbolTickerService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	return res, nil
 }
+
+// WindowSizeMinutes return a window size of n minutes, n in 1..59
+func WindowSizeMinutes(n int) WindowSize {
+	return WindowSize(fmt.Sprintf("%dm", n))
+}
+
+// WindowSizeHours return a window size of n hours, n in 1..23
+func WindowSizeHours(n int) WindowSize {
+	return WindowSize(fmt.Sprintf("%dh", n))
+}
+
+// WindowSizeDays return a window size of n days, n in 1..7
+func WindowSizeDays(n int) WindowSize {
+	return WindowSize(fmt.Sprintf("%dd", n))
+}
+
+// TradingDayTickerService show price change statistics for a trading day
+type TradingDayTickerService struct {
+	c          *Client
+	symbol     *string
+	symbols    []string
+	timeZone   *string
+	tickerType *TickerType
+}
+
+// Symbol set symbol
+func (s *TradingDayTickerService) Symbol(symbol string) *TradingDayTickerService {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *TradingDayTickerService) Symbols(symbols []string) *TradingDayTickerService {
+	s.symbols = symbols
+	return s
+}
+
+// TimeZone set the time zone the trading day starts in, as hours and
+// minutes (e.g. "-1:00", "05:45") or hours only (e.g. "0", "8"). Defaults to 0 (UTC).
+func (s *TradingDayTickerService) TimeZone(timeZone string) *TradingDayTickerService {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Type set ticker type, FULL (default) or MINI. A MINI ticker leaves the price
+// change and weighted average price fields empty.
+func (s *TradingDayTickerService) Type(tickerType TickerType) *TradingDayTickerService {
+	s.tickerType = &tickerType
+	return s
+}
+
+// Do send request
+func (s *TradingDayTickerService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolTicker, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/ticker/tradingDay",
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	} else if s.symbols != nil {
+		s, _ := json.Marshal(s.symbols)
+		r.setParam("symbols", string(s))
+	}
+	if s.timeZone != nil {
+		r.setParam("timeZone", *s.timeZone)
+	}
+	if s.tickerType != nil {
+		r.setParam("type", *s.tickerType)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*SymbolTicker{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*SymbolTicker, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*SymbolTicker{}, err
+	}
+	return res, nil
+}
@@ -556,6 +556,141 @@ func (s *tickerServiceTestSuite) TestListSymbolTicker() {
 	s.assertSymbolTicker(e, res)
 }
 
+func (s *tickerServiceTestSuite) TestListSymbolTickerWindow() {
+	s.mockDo([]byte(`[]`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", "ETHBTC").setParam("windowSize", "4h")
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewListSymbolTickerService().Symbol("ETHBTC").Window(WindowSize4h).Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *tickerServiceTestSuite) TestWindowSize() {
+	s.r().Equal(WindowSize("15m"), WindowSizeMinutes(15))
+	s.r().Equal(WindowSize4h, WindowSizeHours(4))
+	s.r().Equal(WindowSize("7d"), WindowSizeDays(7))
+}
+
+func (s *tickerServiceTestSuite) TestTradingDayTicker() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"priceChange": "-83.13000000",
+			"priceChangePercent": "-0.317",
+			"weightedAvgPrice": "26234.58803036",
+			"openPrice": "26304.80000000",
+			"highPrice": "26397.46000000",
+			"lowPrice": "26088.34000000",
+			"lastPrice": "26221.67000000",
+			"volume": "18495.35066000",
+			"quoteVolume": "485217905.04210480",
+			"openTime": 1695686400000,
+			"closeTime": 1695772799999,
+			"firstId": 3220151555,
+			"lastId": 3220849281,
+			"count": 697727
+		},
+		{
+			"symbol": "BNBUSDT",
+			"openPrice": "214.10000000",
+			"highPrice": "214.80000000",
+			"lowPrice": "210.90000000",
+			"lastPrice": "211.50000000",
+			"volume": "94370.96200000",
+			"quoteVolume": "20086022.88570000",
+			"openTime": 1695686400000,
+			"closeTime": 1695772799999,
+			"firstId": 672336082,
+			"lastId": 672396287,
+			"count": 60206
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbols := []string{"BTCUSDT", "BNBUSDT"}
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbols":  `["BTCUSDT","BNBUSDT"]`,
+			"timeZone": "-1:00",
+			"type":     "MINI",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewTradingDayTickerService().Symbols(symbols).
+		TimeZone("-1:00").Type(TickerTypeMini).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 2)
+	s.assertSymbolTicker([]*SymbolTicker{
+		{
+			Symbol:             "BTCUSDT",
+			PriceChange:        "-83.13000000",
+			PriceChangePercent: "-0.317",
+			WeightedAvgPrice:   "26234.58803036",
+			OpenPrice:          "26304.80000000",
+			HighPrice:          "26397.46000000",
+			LowPrice:           "26088.34000000",
+			LastPrice:          "26221.67000000",
+			Volume:             "18495.35066000",
+			QuoteVolume:        "485217905.04210480",
+			OpenTime:           1695686400000,
+			CloseTime:          1695772799999,
+			FirstId:            3220151555,
+			LastId:             3220849281,
+			Count:              697727,
+		},
+		{
+			Symbol:      "BNBUSDT",
+			OpenPrice:   "214.10000000",
+			HighPrice:   "214.80000000",
+			LowPrice:    "210.90000000",
+			LastPrice:   "211.50000000",
+			Volume:      "94370.96200000",
+			QuoteVolume: "20086022.88570000",
+			OpenTime:    1695686400000,
+			CloseTime:   1695772799999,
+			FirstId:     672336082,
+			LastId:      672396287,
+			Count:       60206,
+		},
+	}, res)
+}
+
+func (s *tickerServiceTestSuite) TestSingleTradingDayTicker() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"openPrice": "26304.80000000",
+		"highPrice": "26397.46000000",
+		"lowPrice": "26088.34000000",
+		"lastPrice": "26221.67000000",
+		"volume": "18495.35066000",
+		"quoteVolume": "485217905.04210480",
+		"openTime": 1695686400000,
+		"closeTime": 1695772799999,
+		"firstId": 3220151555,
+		"lastId": 3220849281,
+		"count": 697727
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewTradingDayTickerService().Symbol("BTCUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal("26221.67000000", res[0].LastPrice)
+	r.Equal(int64(697727), res[0].Count)
+}
+
 func (s *tickerServiceTestSuite) assertSymbolTicker(e, st []*SymbolTicker) {
 	for i := range e {
 		s.r().Equal(e[i].Symbol, st[i].Symbol, "Symbol")
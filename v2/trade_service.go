@@ -3,6 +3,8 @@ package binance
 import (
 	"context"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListTradesService list trades
@@ -152,6 +154,21 @@ type Trade struct {
 	IsIsolated    bool   `json:"isIsolated"`
 }
 
+// PriceDecimal return Price as an exact decimal
+func (t *Trade) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as an exact decimal
+func (t *Trade) QuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as an exact decimal
+func (t *Trade) QuoteQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.QuoteQuantity)
+}
+
 // TradeV3 define v3 trade info
 type TradeV3 struct {
 	ID              int64  `json:"id"`
@@ -170,6 +187,26 @@ type TradeV3 struct {
 	IsIsolated      bool   `json:"isIsolated"`
 }
 
+// PriceDecimal return Price as an exact decimal
+func (t *TradeV3) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as an exact decimal
+func (t *TradeV3) QuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as an exact decimal
+func (t *TradeV3) QuoteQuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.QuoteQuantity)
+}
+
+// CommissionDecimal return Commission as an exact decimal
+func (t *TradeV3) CommissionDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Commission)
+}
+
 // AggTradesService list aggregate trades
 type AggTradesService struct {
 	c         *Client
@@ -253,6 +290,16 @@ type AggTrade struct {
 	IsBestPriceMatch bool   `json:"M"`
 }
 
+// PriceDecimal return Price as an exact decimal
+func (t *AggTrade) PriceDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as an exact decimal
+func (t *AggTrade) QuantityDecimal() (common.Decimal, error) {
+	return common.ParseDecimal(t.Quantity)
+}
+
 // RecentTradesService list recent trades
 type RecentTradesService struct {
 	c      *Client
@@ -538,17 +538,30 @@ type WsOrderUpdate struct {
 	StrategyType            int64           `json:"J"` // Strategy Type
 	WorkingTime             int64           `json:"W"` // Working Time
 	SelfTradePreventionMode string          `json:"V"`
+
+	// set when the order expired because of self-trade prevention
+	PreventedMatchId              int64  `json:"v"`
+	PreventedQuantity             string `json:"A"`
+	LastPreventedQuantity         string `json:"B"`
+	TradeGroupId                  int64  `json:"u"`
+	CounterOrderId                int64  `json:"U"`
+	CounterSymbol                 string `json:"Cs"`
+	PreventedExecutionQuantity    string `json:"pl"`
+	PreventedExecutionPrice       string `json:"pL"`
+	PreventedExecutionQuoteVolume string `json:"pY"`
 }
 
+// WsOCOUpdate define an order list update, for OCO, OTO and OTOCO lists
 type WsOCOUpdate struct {
-	Symbol          string `json:"s"`
-	OrderListId     int64  `json:"g"`
-	ContingencyType string `json:"c"`
-	ListStatusType  string `json:"l"`
-	ListOrderStatus string `json:"L"`
-	RejectReason    string `json:"r"`
-	ClientOrderId   string `json:"C"` // List Client Order ID
-	Orders          WsOCOOrderList
+	Symbol          string         `json:"s"`
+	OrderListId     int64          `json:"g"`
+	ContingencyType string         `json:"c"` // OCO or OTO
+	ListStatusType  string         `json:"l"`
+	ListOrderStatus string         `json:"L"`
+	RejectReason    string         `json:"r"`
+	ClientOrderId   string         `json:"C"` // List Client Order ID
+	TransactionTime int64          `json:"T"`
+	Orders          WsOCOOrderList `json:"-"`
 }
 
 type WsOCOOrderList struct {
@@ -561,6 +574,14 @@ type WsOCOOrder struct {
 	ClientOrderId string `json:"c"`
 }
 
+// wsExecutionReportEvent decode the top level of an executionReport, where
+// "u" and "U" are the trade group and counter order ids of a prevented match
+// decoded in OrderUpdate, not the AccountUpdateTime
+type wsExecutionReportEvent struct {
+	*WsUserDataEvent
+	TradeGroupId int64 `json:"u"`
+}
+
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
@@ -576,8 +597,12 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 		}
 
 		event := new(WsUserDataEvent)
+		var target interface{} = event
+		if UserDataEventType(j.Get("e").MustString()) == UserDataEventTypeExecutionReport {
+			target = &wsExecutionReportEvent{WsUserDataEvent: event}
+		}
 
-		err = json.Unmarshal(message, event)
+		err = json.Unmarshal(message, target)
 		if err != nil {
 			errHandler(err)
 			return
@@ -614,6 +639,12 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 				errHandler(err)
 				return
 			}
+			// the orders are at the top level of the event
+			err = json.Unmarshal(message, &event.OCOUpdate.Orders)
+			if err != nil {
+				errHandler(err)
+				return
+			}
 		}
 
 		handler(event)
@@ -726,6 +757,71 @@ type WsMarketStatEvent struct {
 	Count              int64  `json:"n"`
 }
 
+// WsRollingWindowStatHandler handle websocket that push single market rolling window statistics
+type WsRollingWindowStatHandler func(event *WsRollingWindowStatEvent)
+
+// WsRollingWindowStatServe serve websocket that push rolling window statistics for single market every second,
+// windowSize must be one of WindowSize1h, WindowSize4h and WindowSize1d
+func WsRollingWindowStatServe(symbol string, windowSize WindowSize, handler WsRollingWindowStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker_%s", getWsEndpoint(), strings.ToLower(symbol), windowSize)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		var event WsRollingWindowStatEvent
+		err := json.Unmarshal(message, &event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(&event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsAllRollingWindowStatsHandler handle websocket that push all markets rolling window statistics
+type WsAllRollingWindowStatsHandler func(event WsAllRollingWindowStatsEvent)
+
+// WsAllRollingWindowStatsServe serve websocket that push rolling window statistics for all markets
+// that changed, every second. windowSize must be one of WindowSize1h, WindowSize4h and WindowSize1d
+func WsAllRollingWindowStatsServe(windowSize WindowSize, handler WsAllRollingWindowStatsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!ticker_%s@arr", getWsEndpoint(), windowSize)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		var event WsAllRollingWindowStatsEvent
+		err := json.Unmarshal(message, &event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsAllRollingWindowStatsEvent define array of websocket rolling window statistics events
+type WsAllRollingWindowStatsEvent []*WsRollingWindowStatEvent
+
+// WsRollingWindowStatEvent define websocket rolling window statistics event,
+// Event is the window size followed by "Ticker", e.g. "1hTicker"
+type WsRollingWindowStatEvent struct {
+	Event              string `json:"e"`
+	Time               int64  `json:"E"`
+	Symbol             string `json:"s"`
+	PriceChange        string `json:"p"`
+	PriceChangePercent string `json:"P"`
+	OpenPrice          string `json:"o"`
+	HighPrice          string `json:"h"`
+	LowPrice           string `json:"l"`
+	LastPrice          string `json:"c"`
+	WeightedAvgPrice   string `json:"w"`
+	BaseVolume         string `json:"v"`
+	QuoteVolume        string `json:"q"`
+	OpenTime           int64  `json:"O"`
+	CloseTime          int64  `json:"C"`
+	FirstID            int64  `json:"F"`
+	LastID             int64  `json:"L"`
+	Count              int64  `json:"n"`
+}
+
 // WsAllMiniMarketsStatServeHandler handle websocket that push all mini-ticker market statistics for 24hr
 type WsAllMiniMarketsStatServeHandler func(event WsAllMiniMarketsStatEvent)
 
@@ -773,6 +773,16 @@ func (s *websocketServiceTestSuite) assertOrderUpdate(e, a *WsOrderUpdate) {
 	r.Equal(e.LatestVolume, a.LatestVolume, "OrigCustomOrderId")
 	r.Equal(e.OrigCustomOrderId, a.OrigCustomOrderId, "OrigCustomOrderId")
 	r.Equal(e.RejectReason, a.RejectReason, "RejectReason")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.PreventedMatchId, a.PreventedMatchId, "PreventedMatchId")
+	r.Equal(e.PreventedQuantity, a.PreventedQuantity, "PreventedQuantity")
+	r.Equal(e.LastPreventedQuantity, a.LastPreventedQuantity, "LastPreventedQuantity")
+	r.Equal(e.TradeGroupId, a.TradeGroupId, "TradeGroupId")
+	r.Equal(e.CounterOrderId, a.CounterOrderId, "CounterOrderId")
+	r.Equal(e.CounterSymbol, a.CounterSymbol, "CounterSymbol")
+	r.Equal(e.PreventedExecutionQuantity, a.PreventedExecutionQuantity, "PreventedExecutionQuantity")
+	r.Equal(e.PreventedExecutionPrice, a.PreventedExecutionPrice, "PreventedExecutionPrice")
+	r.Equal(e.PreventedExecutionQuoteVolume, a.PreventedExecutionQuoteVolume, "PreventedExecutionQuoteVolume")
 }
 
 func (s *websocketServiceTestSuite) assertBalanceUpdate(e, a *WsBalanceUpdate) {
@@ -793,6 +803,7 @@ func (s *websocketServiceTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	}
 	s.assertOrderUpdate(&e.OrderUpdate, &a.OrderUpdate)
 	s.assertBalanceUpdate(&e.BalanceUpdate, &a.BalanceUpdate)
+	r.Equal(e.OCOUpdate, a.OCOUpdate, "OCOUpdate")
 }
 
 func (s *websocketServiceTestSuite) testWsUserDataServe(data []byte, expectedEvent *WsUserDataEvent) {
@@ -914,6 +925,137 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeOrderUpdatePrevented() {
+	data := []byte(`{
+	   "e":"executionReport",
+	   "E":1669013658218,
+	   "s":"BTCUSDT",
+	   "c":"web_1",
+	   "S":"BUY",
+	   "o":"LIMIT",
+	   "f":"GTC",
+	   "q":"0.00100000",
+	   "p":"16000.00000000",
+	   "P":"0.00000000",
+	   "F":"0.00000000",
+	   "g":-1,
+	   "C":"",
+	   "x":"TRADE_PREVENTION",
+	   "X":"EXPIRED_IN_MATCH",
+	   "r":"NONE",
+	   "i":12,
+	   "l":"0.00000000",
+	   "z":"0.00000000",
+	   "L":"0.00000000",
+	   "n":"0",
+	   "N":"",
+	   "T":1669013658217,
+	   "t":-1,
+	   "v":3,
+	   "I":25,
+	   "w":false,
+	   "m":false,
+	   "M":false,
+	   "O":1669013658217,
+	   "Z":"0.00000000",
+	   "Y":"0.00000000",
+	   "Q":"0.00000000",
+	   "W":1669013658217,
+	   "V":"EXPIRE_MAKER",
+	   "A":"0.00100000",
+	   "B":"0.00100000",
+	   "u":1,
+	   "U":11,
+	   "Cs":"BTCUSDT",
+	   "pl":"0.00100000",
+	   "pL":"16000.00000000",
+	   "pY":"16.00000000"
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "executionReport",
+		Time:            1669013658218,
+		TransactionTime: 1669013658217,
+		OrderUpdate: WsOrderUpdate{
+			Symbol:                        "BTCUSDT",
+			ClientOrderId:                 "web_1",
+			Side:                          "BUY",
+			Type:                          "LIMIT",
+			TimeInForce:                   "GTC",
+			Volume:                        "0.00100000",
+			Price:                         "16000.00000000",
+			StopPrice:                     "0.00000000",
+			IceBergVolume:                 "0.00000000",
+			OrderListId:                   -1,
+			ExecutionType:                 "TRADE_PREVENTION",
+			Status:                        string(OrderStatusTypeExpiredInMatch),
+			RejectReason:                  "NONE",
+			Id:                            12,
+			LatestVolume:                  "0.00000000",
+			FilledVolume:                  "0.00000000",
+			LatestPrice:                   "0.00000000",
+			FeeCost:                       "0",
+			TransactionTime:               1669013658217,
+			TradeId:                       -1,
+			CreateTime:                    1669013658217,
+			FilledQuoteVolume:             "0.00000000",
+			LatestQuoteVolume:             "0.00000000",
+			QuoteVolume:                   "0.00000000",
+			SelfTradePreventionMode:       string(STPModeExpireMaker),
+			PreventedMatchId:              3,
+			PreventedQuantity:             "0.00100000",
+			LastPreventedQuantity:         "0.00100000",
+			TradeGroupId:                  1,
+			CounterOrderId:                11,
+			CounterSymbol:                 "BTCUSDT",
+			PreventedExecutionQuantity:    "0.00100000",
+			PreventedExecutionPrice:       "16000.00000000",
+			PreventedExecutionQuoteVolume: "16.00000000",
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataServeListStatus() {
+	data := []byte(`{
+	   "e":"listStatus",
+	   "E":1718323184108,
+	   "s":"BTCUSDT",
+	   "g":7,
+	   "c":"OTO",
+	   "l":"EXEC_STARTED",
+	   "L":"EXECUTING",
+	   "r":"NONE",
+	   "C":"oVQOOh4HJZ2ViQyhzO7O5f",
+	   "T":1718323184107,
+	   "O":[
+	      {"s":"BTCUSDT","i":21,"c":"wUgrbXbzzRwk5Sx8hhqj8Q"},
+	      {"s":"BTCUSDT","i":22,"c":"PoJSx8WrPJRGifE4jN0XvM"}
+	   ]
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           UserDataEventTypeListStatus,
+		Time:            1718323184108,
+		TransactionTime: 1718323184107,
+		OCOUpdate: WsOCOUpdate{
+			Symbol:          "BTCUSDT",
+			OrderListId:     7,
+			ContingencyType: string(ContingencyTypeOTO),
+			ListStatusType:  string(ListStatusTypeExecStarted),
+			ListOrderStatus: string(ListOrderStatusTypeExecuting),
+			RejectReason:    "NONE",
+			ClientOrderId:   "oVQOOh4HJZ2ViQyhzO7O5f",
+			TransactionTime: 1718323184107,
+			Orders: WsOCOOrderList{
+				WsOCOOrders: []WsOCOOrder{
+					{Symbol: "BTCUSDT", OrderId: 21, ClientOrderId: "wUgrbXbzzRwk5Sx8hhqj8Q"},
+					{Symbol: "BTCUSDT", OrderId: 22, ClientOrderId: "PoJSx8WrPJRGifE4jN0XvM"},
+				},
+			},
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketServiceTestSuite) TestWsMarketStatServe() {
 	data := []byte(`{
   		"e": "24hrTicker",
@@ -1190,6 +1332,133 @@ func (s *websocketServiceTestSuite) TestWsAllMarketsStatServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) captureWsEndpoint() *string {
+	var endpoint string
+	serve := wsServe
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		endpoint = cfg.Endpoint
+		return serve(cfg, handler, errHandler)
+	}
+	return &endpoint
+}
+
+func (s *websocketServiceTestSuite) TestWsRollingWindowStatServe() {
+	data := []byte(`{
+		"e": "1hTicker",
+		"E": 1672515782136,
+		"s": "BNBBTC",
+		"p": "0.0015",
+		"P": "250.00",
+		"o": "0.0010",
+		"h": "0.0025",
+		"l": "0.0010",
+		"c": "0.0025",
+		"w": "0.0018",
+		"v": "10000",
+		"q": "18",
+		"O": 0,
+		"C": 1675216573749,
+		"F": 0,
+		"L": 18150,
+		"n": 18151
+	}`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	endpoint := s.captureWsEndpoint()
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsRollingWindowStatServe("BNBBTC", WindowSize1h, func(event *WsRollingWindowStatEvent) {
+		e := &WsRollingWindowStatEvent{
+			Event:              "1hTicker",
+			Time:               1672515782136,
+			Symbol:             "BNBBTC",
+			PriceChange:        "0.0015",
+			PriceChangePercent: "250.00",
+			OpenPrice:          "0.0010",
+			HighPrice:          "0.0025",
+			LowPrice:           "0.0010",
+			LastPrice:          "0.0025",
+			WeightedAvgPrice:   "0.0018",
+			BaseVolume:         "10000",
+			QuoteVolume:        "18",
+			OpenTime:           0,
+			CloseTime:          1675216573749,
+			FirstID:            0,
+			LastID:             18150,
+			Count:              18151,
+		}
+		s.r().Equal(e, event)
+	}, func(err error) {
+		s.r().EqualError(err, fakeErrMsg)
+	})
+	s.r().NoError(err)
+	s.r().Equal(baseWsMainURL+"/bnbbtc@ticker_1h", *endpoint)
+	stopC <- struct{}{}
+	<-doneC
+}
+
+func (s *websocketServiceTestSuite) TestWsAllRollingWindowStatsServe() {
+	data := []byte(`[{
+		"e": "4hTicker",
+		"E": 1672515782136,
+		"s": "BNBBTC",
+		"p": "0.0015",
+		"P": "250.00",
+		"o": "0.0010",
+		"h": "0.0025",
+		"l": "0.0010",
+		"c": "0.0025",
+		"w": "0.0018",
+		"v": "10000",
+		"q": "18",
+		"O": 0,
+		"C": 1675216573749,
+		"F": 0,
+		"L": 18150,
+		"n": 18151
+	},{
+		"e": "4hTicker",
+		"E": 1672515782136,
+		"s": "ETHBTC",
+		"p": "-0.0001",
+		"P": "-0.15",
+		"o": "0.0685",
+		"h": "0.0690",
+		"l": "0.0680",
+		"c": "0.0684",
+		"w": "0.0686",
+		"v": "2500",
+		"q": "171.5",
+		"O": 1672501382136,
+		"C": 1672515782136,
+		"F": 100,
+		"L": 200,
+		"n": 101
+	}]`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	endpoint := s.captureWsEndpoint()
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsAllRollingWindowStatsServe(WindowSize4h, func(event WsAllRollingWindowStatsEvent) {
+		r := s.r()
+		r.Len(event, 2)
+		r.Equal("4hTicker", event[0].Event)
+		r.Equal("BNBBTC", event[0].Symbol)
+		r.Equal("ETHBTC", event[1].Symbol)
+		r.Equal("-0.0001", event[1].PriceChange)
+		r.Equal("0.0686", event[1].WeightedAvgPrice)
+		r.Equal(int64(1672501382136), event[1].OpenTime)
+		r.Equal(int64(101), event[1].Count)
+	}, func(err error) {
+		s.r().EqualError(err, fakeErrMsg)
+	})
+	s.r().NoError(err)
+	s.r().Equal(baseWsMainURL+"/!ticker_4h@arr", *endpoint)
+	stopC <- struct{}{}
+	<-doneC
+}
+
 func (s *websocketServiceTestSuite) assertWsAllMarketsStatEventEqual(e, a WsAllMarketsStatEvent) {
 	for i := range e {
 		s.assertWsMarketStatEventEqual(e[i], a[i])